@@ -15,7 +15,9 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
 
 	"github.com/Casagrande-Lucas/dnd/config"
 	_ "github.com/Casagrande-Lucas/dnd/docs"
@@ -25,6 +27,11 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
 	r := gin.Default()
 	cfg := config.GetConfig()
 	factoryDB := db.GetDBFactory()
@@ -34,6 +41,25 @@ func main() {
 		log.Fatalf("Error connecting to postgres: %v", err)
 	}
 
+	if cfg.DB.AutoMigrate && cfg.APP.ENV == "dev" {
+		log.Println("db.autoMigrate is set, creating tables with GORM AutoMigrate")
+		if err := db.AutoMigrate(dbConn); err != nil {
+			log.Fatalf("Error migrating database: %v", err)
+		}
+	} else {
+		migrator, err := newMigrator(dbConn)
+		if err != nil {
+			log.Fatalf("Error loading migrations: %v", err)
+		}
+		pending, err := migrator.Pending(context.Background())
+		if err != nil {
+			log.Fatalf("Error checking migrations: %v", err)
+		}
+		if pending > 0 {
+			log.Fatalf("database schema is %d migration(s) behind, run `dnd migrate up` first", pending)
+		}
+	}
+
 	srv := api.NewGinRoutes(r, cfg, dbConn.GetDB())
 	if err := srv.StartServer(); err != nil {
 		log.Fatalf("failed to run server: %v", err)
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/Casagrande-Lucas/dnd/config"
+	"github.com/Casagrande-Lucas/dnd/infrastructure/db"
+	"github.com/Casagrande-Lucas/dnd/infrastructure/db/migrations"
+)
+
+const migrateUsage = `usage: dnd migrate [-dir path] <command> [arguments]
+
+commands:
+  up [n]         apply all pending migrations, or only the next n
+  down [n]       revert the latest applied migration, or the latest n
+  status         list every migration and whether it is applied
+  create <name>  add empty up and down files for a new migration
+`
+
+// runMigrate runs the migrate subcommand with the given arguments.
+func runMigrate(args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dir := flags.String("dir", "infrastructure/db/migrations/sql", "directory where create writes new migrations")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	command, rest := flags.Arg(0), flags.Args()[1:]
+
+	if command == "create" {
+		if len(rest) != 1 {
+			log.Fatal("usage: dnd migrate create <name>")
+		}
+		up, down, err := migrations.Create(*dir, rest[0])
+		if err != nil {
+			log.Fatalf("Error creating migration: %v", err)
+		}
+		fmt.Printf("created %s\ncreated %s\n", up, down)
+		return
+	}
+
+	cfg := config.GetConfig()
+	dbConn, err := db.GetDBFactory().CreatePostgresConnection("postgres", cfg.DB.DSN)
+	if err != nil {
+		log.Fatalf("Error connecting to postgres: %v", err)
+	}
+	migrator, err := newMigrator(dbConn)
+	if err != nil {
+		log.Fatalf("Error loading migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx, parseSteps(rest, 0))
+		for _, migration := range applied {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Error applying migrations: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx, parseSteps(rest, 1))
+		for _, migration := range reverted {
+			fmt.Printf("reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalf("Error reverting migrations: %v", err)
+		}
+		if len(reverted) == 0 {
+			fmt.Println("no applied migrations")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Error reading migration status: %v", err)
+		}
+		printStatus(statuses)
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+}
+
+// newMigrator creates a migrator for the migrations embedded in the binary.
+func newMigrator(dbConn db.DB) (*migrations.Migrator, error) {
+	sqlDB, err := dbConn.GetDB().DB()
+	if err != nil {
+		return nil, err
+	}
+	embedded, err := migrations.Embedded()
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(sqlDB, embedded), nil
+}
+
+// parseSteps reads the optional number of migrations to apply or revert.
+func parseSteps(args []string, fallback int) int {
+	if len(args) == 0 {
+		return fallback
+	}
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps <= 0 {
+		log.Fatalf("the number of migrations must be a positive integer: %s", args[0])
+	}
+	return steps
+}
+
+func printStatus(statuses []migrations.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", ""
+		if status.Applied {
+			state, appliedAt = "applied", status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		if status.Modified {
+			state = "modified"
+		}
+		if status.Missing {
+			state = "missing"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	w.Flush()
+}
@@ -25,8 +25,9 @@ type (
 	}
 
 	DB struct {
-		Type       string
-		DSN        string
+		Type        string
+		DSN         string
+		AutoMigrate bool
 	}
 
 	CORS struct {
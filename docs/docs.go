@@ -23,9 +23,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/races": {
-            "get": {
-                "description": "Return all registered races",
+        "/ability-scores/point-buy": {
+            "post": {
+                "description": "Check base ability scores bought with 27 points, each between 8 and 15, and return their cost and modifiers",
                 "consumes": [
                     "application/json"
                 ],
@@ -33,29 +33,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Ability Scores"
+                ],
+                "summary": "Validate point-buy scores",
+                "parameters": [
+                    {
+                        "description": "Base ability scores",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityScoresRequest"
+                        }
+                    }
                 ],
-                "summary": "List all races",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Race"
-                            }
+                            "$ref": "#/definitions/models.AbilityScoreSet"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/ability-scores/racial": {
             "post": {
-                "description": "Create a new race",
+                "description": "Combine base ability scores with the fixed and chosen ability score bonuses of a race and, optionally, one of its subraces",
                 "consumes": [
                     "application/json"
                 ],
@@ -63,25 +73,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Ability Scores"
                 ],
-                "summary": "Create race",
+                "summary": "Apply racial bonuses",
                 "parameters": [
                     {
-                        "description": "Race info",
-                        "name": "race",
+                        "description": "Base scores and race",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Race"
+                            "$ref": "#/definitions/models.RacialAbilityScoresRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Race"
+                            "$ref": "#/definitions/models.RacialAbilityScores"
                         }
                     },
                     "400": {
@@ -99,9 +109,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/races/search": {
-            "get": {
-                "description": "Search for races based on query parameters",
+        "/ability-scores/roll": {
+            "post": {
+                "description": "Roll 4d6 and drop the lowest die for each ability, in the order strength, dexterity, constitution, intelligence, wisdom, charisma. The seed used is returned so the roll can be reproduced.",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,39 +119,146 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Ability Scores"
                 ],
-                "summary": "Search races",
+                "summary": "Roll ability scores",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Key to filter",
-                        "name": "key",
+                        "type": "integer",
+                        "description": "Seed for a reproducible roll",
+                        "name": "seed",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityScoreSet"
+                        }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ability-scores/standard-array": {
+            "post": {
+                "description": "Check that base ability scores assign each standard array value (15, 14, 13, 12, 10, 8) exactly once and return their modifiers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ability Scores"
+                ],
+                "summary": "Validate standard array assignment",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Value to filter",
-                        "name": "value",
-                        "in": "query"
+                        "description": "Base ability scores",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityScoresRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityScoreSet"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
                     }
+                }
+            }
+        },
+        "/backgrounds": {
+            "get": {
+                "description": "Return all registered backgrounds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backgrounds"
                 ],
+                "summary": "List all backgrounds",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Race"
+                                "$ref": "#/definitions/models.Background"
                             }
                         }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new background with its proficiencies, languages, equipment and personality tables. Skill and tool proficiencies link catalog proficiencies of that category by ID or name; unknown ones are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Backgrounds"
+                ],
+                "summary": "Create background",
+                "parameters": [
+                    {
+                        "description": "Background info",
+                        "name": "background",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Background"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Background"
+                        }
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -151,9 +268,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/races/{id}": {
+        "/backgrounds/{id}": {
             "get": {
-                "description": "Retrieve a race using the provided ID",
+                "description": "Retrieve a background using the provided ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -161,13 +278,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Backgrounds"
                 ],
-                "summary": "Get race by ID",
+                "summary": "Get background by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Background ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -177,7 +294,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Race"
+                            "$ref": "#/definitions/models.Background"
                         }
                     },
                     "400": {
@@ -195,7 +312,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing race",
+                "description": "Update an existing background, replacing its proficiencies and languages. Skill and tool proficiencies link catalog proficiencies of that category by ID or name; unknown ones are rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -203,24 +320,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Backgrounds"
                 ],
-                "summary": "Update race",
+                "summary": "Update background",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Background ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Race info",
-                        "name": "race",
+                        "description": "Background info",
+                        "name": "background",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Race"
+                            "$ref": "#/definitions/models.Background"
                         }
                     }
                 ],
@@ -228,7 +345,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Race"
+                            "$ref": "#/definitions/models.Background"
                         }
                     },
                     "400": {
@@ -242,19 +359,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
                     }
                 }
             },
             "delete": {
-                "description": "Delete an existing race",
+                "description": "Delete an existing background",
                 "tags": [
-                    "Races"
+                    "Backgrounds"
                 ],
-                "summary": "Delete race",
+                "summary": "Delete background",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Background ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -279,9 +402,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/races/{id}/subraces": {
+        "/backgrounds/{id}/personality": {
             "post": {
-                "description": "Add a new subrace to an existing race",
+                "description": "Draw two personality traits and one ideal, bond and flaw from the background's tables",
                 "consumes": [
                     "application/json"
                 ],
@@ -289,32 +412,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Backgrounds"
                 ],
-                "summary": "Add subrace",
+                "summary": "Roll personality",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Background ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Subrace info",
-                        "name": "subrace",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Subrace"
-                        }
+                        "type": "integer",
+                        "description": "Seed for a reproducible roll",
+                        "name": "seed",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Subrace"
+                            "$ref": "#/definitions/models.BackgroundPersonality"
                         }
                     },
                     "400": {
@@ -323,8 +443,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
@@ -332,9 +452,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/races/{id}/subraces/{subraceID}": {
-            "delete": {
-                "description": "Remove an existing subrace from a race",
+        "/character-drafts": {
+            "post": {
+                "description": "Start a new guided character creation draft",
                 "consumes": [
                     "application/json"
                 ],
@@ -342,37 +462,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
-                ],
-                "summary": "Remove subrace",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Race ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Subrace ID (UUID)",
-                        "name": "subraceID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Character Drafts"
                 ],
+                "summary": "Start character draft",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/httperror.ErrorResponse"
+                            "$ref": "#/definitions/models.CharacterDraft"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/httperror.ErrorResponse"
                         }
@@ -380,9 +481,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/races/{id}/traits/{traitID}": {
-            "post": {
-                "description": "Add a new trait to an existing race",
+        "/character-drafts/{id}": {
+            "get": {
+                "description": "Retrieve a character draft with its completed and missing steps",
                 "consumes": [
                     "application/json"
                 ],
@@ -390,28 +491,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Races"
+                    "Character Drafts"
                 ],
-                "summary": "Add trait to race",
+                "summary": "Get character draft by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Character draft ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Trait ID (UUID)",
-                        "name": "traitID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -428,31 +525,18 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove an existing trait from a race",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete a character draft. Characters already created from it are kept.",
                 "tags": [
-                    "Races"
+                    "Character Drafts"
                 ],
-                "summary": "Remove trait from race",
+                "summary": "Discard character draft",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Race ID (UUID)",
+                        "description": "Character draft ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Trait ID (UUID)",
-                        "name": "traitID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -473,102 +557,8436 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "httperror.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error_code": {
-                    "type": "string"
-                },
-                "error_message": {
-                    "type": "string"
+        },
+        "/character-drafts/{id}/ability-scores": {
+            "put": {
+                "description": "Set the base ability scores of the character, each between 3 and 18. Reopens the hit points step.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Set ability scores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ability scores",
+                        "name": "scores",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityScores"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/background": {
+            "put": {
+                "description": "Choose the background of the character. Reopens the skills step.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose background",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Background",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftBackgroundStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/class": {
+            "put": {
+                "description": "Choose the class of the character. Spellcasting classes also require the spells step. Reopens the hit points and spells steps.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Class",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftClassStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/description": {
+            "put": {
+                "description": "Set the name, alignment and personality of the character",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Describe character",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftDescriptionStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/equipment": {
+            "put": {
+                "description": "Choose the starting equipment of the character",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose equipment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Equipment",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftEquipmentStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/finalize": {
+            "post": {
+                "description": "Create the character once every required step is complete. The draft is kept, marked as finalized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Finalize character draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/hit-points": {
+            "post": {
+                "description": "Calculate the level 1 hit points and unarmored armor class from the class hit die and the racial ability scores",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Calculate hit points",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/race": {
+            "put": {
+                "description": "Choose the race and, when the race has subraces, the subrace of the character, answering every ability bonus choice they offer. Reopens the hit points step.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Race",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftRaceStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/skills": {
+            "put": {
+                "description": "Choose skill proficiencies beyond the ones granted by the background",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose skills",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skills",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftSkillsStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/character-drafts/{id}/spells": {
+            "put": {
+                "description": "Choose the starting spells of a spellcasting character",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Drafts"
+                ],
+                "summary": "Choose spells",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character draft ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spells",
+                        "name": "step",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DraftSpellsStep"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterDraft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/characters": {
+            "get": {
+                "description": "Return all registered characters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Characters"
+                ],
+                "summary": "List all characters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Character"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new character, applying the ability score bonuses, size, speed, languages and proficiencies of its race and subrace. ability_bonus_picks must answer every ability bonus choice they offer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Characters"
+                ],
+                "summary": "Create character",
+                "parameters": [
+                    {
+                        "description": "Character info",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/characters/{id}": {
+            "get": {
+                "description": "Retrieve a character using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Characters"
+                ],
+                "summary": "Get character by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing character and re-apply its race and subrace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Characters"
+                ],
+                "summary": "Update character",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Character info",
+                        "name": "character",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing character",
+                "tags": [
+                    "Characters"
+                ],
+                "summary": "Delete character",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/characters/{id}/experience": {
+            "post": {
+                "description": "Add experience points to a character that advances by experience",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Levels"
+                ],
+                "summary": "Award experience",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Experience points",
+                        "name": "award",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ExperienceAward"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/characters/{id}/level-history": {
+            "get": {
+                "description": "Retrieve every level gained by a character, in level order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Levels"
+                ],
+                "summary": "Get level history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CharacterLevelUp"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/characters/{id}/level-up": {
+            "get": {
+                "description": "Show the features, hit points and ability score improvement a character gains at its next level, and whether it has the experience to advance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Levels"
+                ],
+                "summary": "Preview next level",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LevelUpPreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Advance a character one level in its class. Hit points are rolled or averaged from the hit die plus the Constitution modifier, and levels granting an ability score improvement require ability increases or a feat.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Levels"
+                ],
+                "summary": "Level up character",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Level up choices",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LevelUpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CharacterLevelUp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Undo the latest level gained by a character, restoring its previous level, ability scores, hit points and feats",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Character Levels"
+                ],
+                "summary": "Revert last level up",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Character ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Character"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes": {
+            "get": {
+                "description": "Return all registered classes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "List all classes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Class"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new class with its proficiencies and level features. Proficiencies and multiclass proficiencies link catalog proficiencies by ID or name; unknown ones are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Create class",
+                "parameters": [
+                    {
+                        "description": "Class info",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Class"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Class"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}": {
+            "get": {
+                "description": "Retrieve a class using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Get class by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Class"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing class, replacing its proficiencies and level features. Proficiencies and multiclass proficiencies link catalog proficiencies by ID or name; unknown ones are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Update class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Class info",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Class"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Class"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing class",
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Delete class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/levels": {
+            "get": {
+                "description": "Return the proficiency bonus and features gained at every level from 1 to 20",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Get class progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ClassLevel"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/levels/{level}": {
+            "get": {
+                "description": "Return the proficiency bonus and features a class gains at the given level",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Classes"
+                ],
+                "summary": "Get class level",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Class level (1-20)",
+                        "name": "level",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassLevel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/spells": {
+            "get": {
+                "description": "Return the spells on a class's list that a character of the given class level can learn, cantrips included",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "List spells a class can learn",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Class level (1-20)",
+                        "name": "level",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassSpellList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conditions": {
+            "get": {
+                "description": "Return the conditions of the catalog ordered by name, optionally filtered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "List all conditions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name fragment",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Conditions with levels, such as exhaustion",
+                        "name": "leveled",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Conditions that incapacitate",
+                        "name": "incapacitating",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Condition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new condition with its structured mechanics",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "Create condition",
+                "parameters": [
+                    {
+                        "description": "Condition info",
+                        "name": "condition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Condition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Condition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conditions/standard": {
+            "post": {
+                "description": "Add the conditions of the System Reference Document that the catalog does not have yet, matched by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "Install standard conditions",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Condition"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/conditions/{id}": {
+            "get": {
+                "description": "Retrieve a condition using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "Get condition by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condition ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Condition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing condition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "Update condition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condition ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Condition info",
+                        "name": "condition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Condition"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Condition"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing condition and every active effect of it",
+                "tags": [
+                    "Conditions"
+                ],
+                "summary": "Delete condition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condition ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dice/roll": {
+            "post": {
+                "description": "Roll a dice expression such as 2d6+3, 4d6kh3, 1d20adv or 3d6! and return every die. The seed used is returned so the roll can be reproduced.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dice"
+                ],
+                "summary": "Roll dice",
+                "parameters": [
+                    {
+                        "description": "Expression and optional seed",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DiceRollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DiceRoll"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dice/stats": {
+            "post": {
+                "description": "Compute the minimum, maximum, average and exact probability distribution of a dice expression",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dice"
+                ],
+                "summary": "Dice statistics",
+                "parameters": [
+                    {
+                        "description": "Expression",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DiceStatsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dice.Stats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/effects": {
+            "get": {
+                "description": "Return the active effects with their conditions, oldest first, optionally filtered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "List active effects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target ID (UUID)",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source ID (UUID)",
+                        "name": "source_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Condition ID (UUID)",
+                        "name": "condition_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Effects that depend on the concentration of their source",
+                        "name": "concentration",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ActiveEffect"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Apply a condition of the catalog or a custom effect to one or more targets. An effect a target already has is replaced. Starting a concentration effect ends the previous concentration of its source, and incapacitating a target ends the target's own concentration. Timed durations last at most a year of game time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "Apply effect",
+                "parameters": [
+                    {
+                        "description": "Effect to apply",
+                        "name": "effect",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ApplyEffectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ApplyEffectResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/effects/advance": {
+            "post": {
+                "description": "Count down the timed effects of the given targets, or of every target, by the given rounds, minutes and hours, at most a year of game time in total. Effects whose duration runs out expire and are removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "Advance game time",
+                "parameters": [
+                    {
+                        "description": "Time to advance",
+                        "name": "time",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AdvanceTimeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AdvanceTimeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/effects/concentration/{sourceID}": {
+            "delete": {
+                "description": "Break the concentration of a source, ending every effect that depends on it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "End concentration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source ID (UUID)",
+                        "name": "sourceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ActiveEffect"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/effects/effective": {
+            "get": {
+                "description": "Apply the active effects of a target to the base speed and size of its race and subrace, for example halving speed at the second level of exhaustion",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "Get effective speed and size",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target ID (UUID)",
+                        "name": "target_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "race_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subrace_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EffectiveStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/effects/{id}": {
+            "get": {
+                "description": "Retrieve an active effect using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "Get active effect by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Active effect ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ActiveEffect"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "End an active effect before it expires",
+                "tags": [
+                    "Active Effects"
+                ],
+                "summary": "Remove active effect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Active effect ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/equipment": {
+            "get": {
+                "description": "Return all registered items ordered by category and name, optionally filtered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Equipment"
+                ],
+                "summary": "List all equipment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name fragment",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category (adventuring_gear, weapon, armor, tool, mount or trade_good)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum weight in pounds",
+                        "name": "max_weight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum cost in copper pieces",
+                        "name": "max_cost",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Weapon kind (simple or martial)",
+                        "name": "weapon_kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Weapon range (melee or ranged)",
+                        "name": "weapon_range",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Weapon damage type",
+                        "name": "damage_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Weapon property, such as finesse or two_handed",
+                        "name": "property",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Armor type (light, medium, heavy or shield)",
+                        "name": "armor_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Armor imposes disadvantage on stealth",
+                        "name": "stealth_disadvantage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Equipment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Equipment"
+                ],
+                "summary": "Create equipment",
+                "parameters": [
+                    {
+                        "description": "Equipment info",
+                        "name": "equipment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Equipment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Equipment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/equipment/{id}": {
+            "get": {
+                "description": "Retrieve an item using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Equipment"
+                ],
+                "summary": "Get equipment by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Equipment ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Equipment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Equipment"
+                ],
+                "summary": "Update equipment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Equipment ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Equipment info",
+                        "name": "equipment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Equipment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Equipment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing item",
+                "tags": [
+                    "Equipment"
+                ],
+                "summary": "Delete equipment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Equipment ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/languages": {
+            "get": {
+                "description": "Return all registered languages, optionally filtered by type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "List all languages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Language type (standard or exotic)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Language"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "Create language",
+                "parameters": [
+                    {
+                        "description": "Language info",
+                        "name": "language",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Language"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Language"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/languages/{id}": {
+            "get": {
+                "description": "Retrieve a language using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "Get language by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Language ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Language"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "Update language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Language ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Language info",
+                        "name": "language",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Language"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Language"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing language. Languages still known by a race or subrace are only deleted when force is true.",
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "Delete language",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Language ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also remove the language from every race and subrace knowing it",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/languages/{id}/speakers": {
+            "get": {
+                "description": "Return the races and subraces that know the language",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Languages"
+                ],
+                "summary": "List language speakers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Language ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LanguageSpeakers"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/proficiencies": {
+            "get": {
+                "description": "Return all registered proficiencies, optionally filtered by category, governing ability or name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Proficiencies"
+                ],
+                "summary": "List all proficiencies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category (skill, tool, weapon, armor or saving_throw)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Governing ability",
+                        "name": "ability",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name fragment",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Proficiency"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new proficiency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Proficiencies"
+                ],
+                "summary": "Create proficiency",
+                "parameters": [
+                    {
+                        "description": "Proficiency info",
+                        "name": "proficiency",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Proficiency"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Proficiency"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/proficiencies/{id}": {
+            "get": {
+                "description": "Retrieve a proficiency using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Proficiencies"
+                ],
+                "summary": "Get proficiency by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proficiency ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Proficiency"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing proficiency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Proficiencies"
+                ],
+                "summary": "Update proficiency",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proficiency ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Proficiency info",
+                        "name": "proficiency",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Proficiency"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Proficiency"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing proficiency. Proficiencies still granted by a race or subrace are only deleted when force is true.",
+                "tags": [
+                    "Proficiencies"
+                ],
+                "summary": "Delete proficiency",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proficiency ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also remove the proficiency from every race and subrace granting it",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races": {
+            "get": {
+                "description": "Return one page of races. Pages are selected with limit and either offset or the cursor of the previous page, which stays stable while races are added. The total number of races is returned in the X-Total-Count header and the first, previous, next and last pages in the Link header. Every association is loaded by default, as before pagination; once include or fields is given, only the associations it names are loaded, and an empty include loads none.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "List races",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Races per page, 20 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of races to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the next page from the Link or X-Next-Cursor header",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields among name, size, speed and alignment, prefixed with - for descending order, name by default",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields of each race to return",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated associations to load among age, proficiencies, languages_known, traits and subraces, all by default and none when empty",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Race"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links to the first, previous, next and last pages"
+                            },
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page, absent on the last page"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of races"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new race. Proficiencies, including those of subraces, link catalog proficiencies by ID or name; unknown ones are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Create race",
+                "parameters": [
+                    {
+                        "description": "Race info",
+                        "name": "race",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/search": {
+            "get": {
+                "description": "Search races with filters written as field=value or field[operator]=value, for example speed[gte]=30, name[ilike]=elf, trait=Darkvision or bonus.dexterity[gt]=0. Filters are combined with AND. Filters prefixed with or.\u003cgroup\u003e., such as or.1.size=Small\u0026or.1.speed[gte]=35, match when any filter of their group does. The operators are eq, ne, gt, gte, lt, lte, like, ilike and in, which takes comma-separated values. trait, language, proficiency and subrace match the names of the related entities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Search races",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Walking speed in feet",
+                        "name": "speed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alignment",
+                        "name": "alignment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of a trait of the race",
+                        "name": "trait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of a language of the race",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of a proficiency of the race",
+                        "name": "proficiency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of a subrace of the race",
+                        "name": "subrace",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Race"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}": {
+            "get": {
+                "description": "Retrieve a race using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Get race by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing race. Proficiencies, including those of subraces, link catalog proficiencies by ID or name; unknown ones are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Update race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Race info",
+                        "name": "race",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing race",
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Delete race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Change part of a race without resending it. Send a JSON Merge Patch (RFC 7396) as application/merge-patch+json or application/json, or a JSON Patch (RFC 6902) as application/json-patch+json. Only the fields the patch changes are written, and the patched race is validated like a new one. Subraces listed with their ID are updated, those without an ID are added and omitted ones are removed. Languages and traits are linked by the ID of a catalog entry and proficiencies by ID or name; their own fields are edited through their catalogs, so a patch changing them is rejected. A failing test operation returns 409.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Patch race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch object or array of JSON Patch operations",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Race"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}/ability-bonuses": {
+            "post": {
+                "description": "Combine the fixed ability bonuses of a race and, optionally, one of its subraces with the player's answers to their ability bonus choices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Resolve ability bonuses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Subrace and picks",
+                        "name": "selection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AbilityBonusSelection"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResolvedAbilityBonuses"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}/subraces": {
+            "get": {
+                "description": "Return every subrace of a race with its traits, proficiencies and languages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subraces"
+                ],
+                "summary": "List subraces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Subrace"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a new subrace to an existing race",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Add subrace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Subrace info",
+                        "name": "subrace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}/subraces/{subraceID}": {
+            "get": {
+                "description": "Retrieve a subrace of a race using the provided IDs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subraces"
+                ],
+                "summary": "Get subrace by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subraceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace a subrace's details, traits, proficiencies and languages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subraces"
+                ],
+                "summary": "Update subrace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subraceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Subrace info",
+                        "name": "subrace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an existing subrace from a race",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Remove subrace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subraceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only the subrace fields present in the request body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Subraces"
+                ],
+                "summary": "Patch subrace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subraceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Subrace fields to update",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubracePatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Subrace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}/subraces/{subraceID}/effective": {
+            "get": {
+                "description": "Merge a race with one of its subraces into a single profile, recording the source of every field",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Get effective race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subrace ID (UUID)",
+                        "name": "subraceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EffectiveRace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/races/{id}/traits/{traitID}": {
+            "post": {
+                "description": "Add a new trait to an existing race",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Add trait to race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "traitID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an existing trait from a race",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Races"
+                ],
+                "summary": "Remove trait from race",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Race ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "traitID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/derived-stats": {
+            "post": {
+                "description": "Calculate proficiency bonus, ability modifiers, hit points, armor class, initiative, speed, skill modifiers and passive perception from final ability scores, race, level and worn armor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Calculate derived stats",
+                "parameters": [
+                    {
+                        "description": "Character build",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DerivedStatsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DerivedStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/multiclass": {
+            "post": {
+                "description": "Apply the multiclassing rules to per-class levels: check the ability prerequisites of every class against the scores with racial bonuses applied, compute the combined caster level, spell slots and pact magic, and list the proficiencies gained from each class after the first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rules"
+                ],
+                "summary": "Check multiclassing",
+                "parameters": [
+                    {
+                        "description": "Classes, race and base ability scores",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MulticlassRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Multiclass"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Full-text search over the names and descriptions of races, subraces and traits, tolerant to typos in names. Hits are ranked best first and grouped by type, with the matched words of the name and description wrapped in \u003cmark\u003e tags. The name and snippet are HTML-escaped, so the tags are their only markup.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search races, subraces and traits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text, such as dwarf hill",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated types to search among race, subrace and trait, all by default",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Hits per type, 10 by default and at most 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/suggest": {
+            "get": {
+                "description": "Autocomplete the names of races, subraces and traits from the text typed so far. Names starting with the text come first, followed by names containing a similar word, so misspellings such as darkvsion still suggest Darkvision.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Suggest names",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text typed so far",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of suggestions, 10 by default and at most 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Suggestion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots": {
+            "get": {
+                "description": "Retrieve spell slot trackers, optionally only the one of an owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "List spell slot trackers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Owner ID (UUID)",
+                        "name": "owner_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SpellSlotTracker"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start tracking the spell slots of an owner, from explicit slot counts or from caster and pact levels. Each owner has at most one tracker.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Create spell slot tracker",
+                "parameters": [
+                    {
+                        "description": "Maximum slots",
+                        "name": "tracker",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTrackerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots/{id}": {
+            "get": {
+                "description": "Retrieve a spell slot tracker using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Get spell slot tracker by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the maximum slots of a tracker, for example after a level up. Spent slots stay spent up to the new maximum.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Update spell slot tracker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Maximum slots",
+                        "name": "tracker",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTrackerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop tracking spell slots",
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Delete spell slot tracker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots/{id}/consume": {
+            "post": {
+                "description": "Spend a slot to cast a spell, either at the spell's level, at a higher slot level, or with the lowest higher slot left when upcasting is allowed. Fails with 409 when no suitable slot is left.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Consume spell slot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spell to cast",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ConsumeSlotRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SlotConsumption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots/{id}/rest/long": {
+            "post": {
+                "description": "Recover every spell slot and pact magic slot",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Long rest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots/{id}/rest/short": {
+            "post": {
+                "description": "Recover every pact magic slot",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Short rest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spell-slots/{id}/restore": {
+            "post": {
+                "description": "Recover spent slots of one level, or pact magic slots, such as with Arcane Recovery",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spell Slots"
+                ],
+                "summary": "Restore spell slots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell slot tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slots to recover",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RestoreSlotRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SpellSlotTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spells": {
+            "get": {
+                "description": "Return all registered spells ordered by level and name, optionally filtered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "List all spells",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name fragment",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Exact spell level (0 for cantrips)",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum spell level",
+                        "name": "min_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum spell level",
+                        "name": "max_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "School of magic",
+                        "name": "school",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only spells on this class's list (UUID)",
+                        "name": "class_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive casting time fragment",
+                        "name": "casting_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Requires concentration",
+                        "name": "concentration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Can be cast as a ritual",
+                        "name": "ritual",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Has a verbal component",
+                        "name": "verbal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Has a somatic component",
+                        "name": "somatic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Has a material component",
+                        "name": "material",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Consumes its material component",
+                        "name": "material_consumed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Has a material component with a listed cost",
+                        "name": "costly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Spell"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new spell",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "Create spell",
+                "parameters": [
+                    {
+                        "description": "Spell info",
+                        "name": "spell",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Spell"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spell"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/spells/{id}": {
+            "get": {
+                "description": "Retrieve a spell using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "Get spell by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spell"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing spell",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "Update spell",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Spell info",
+                        "name": "spell",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Spell"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Spell"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing spell and remove it from every class list",
+                "tags": [
+                    "Spells"
+                ],
+                "summary": "Delete spell",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Spell ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers": {
+            "get": {
+                "description": "Retrieve resource trackers, optionally only the one of an owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "List resource trackers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Owner ID (UUID)",
+                        "name": "owner_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ResourceTracker"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start tracking the limited-use resources of an owner. The limited-use traits of the given race and subrace and the given hit dice are added automatically. Each owner has at most one tracker.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Create resource tracker",
+                "parameters": [
+                    {
+                        "description": "Resources",
+                        "name": "tracker",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTrackerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}": {
+            "get": {
+                "description": "Retrieve a resource tracker using the provided ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Get resource tracker by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the resources of a tracker, for example after a level up. Spent uses of a kept resource stay spent up to its new maximum.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Update resource tracker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resources",
+                        "name": "tracker",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTrackerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop tracking limited-use resources",
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Delete resource tracker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}/dawn": {
+            "post": {
+                "description": "Recover every resource that recharges at dawn",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Dawn",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}/recharge": {
+            "post": {
+                "description": "Roll a d6 for every spent resource with a recharge roll, such as Recharge 5-6, recovering those that succeed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Roll recharges",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional seed",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.RechargeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}/rest/long": {
+            "post": {
+                "description": "Recover every short and long rest resource and half of the hit dice",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Long rest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}/rest/short": {
+            "post": {
+                "description": "Recover every short rest resource, optionally spending hit dice to regain hit points first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Short rest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hit dice to spend",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ShortRestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trackers/{id}/use": {
+            "post": {
+                "description": "Spend uses of a resource. Fails with 409 when not enough uses are left.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource Trackers"
+                ],
+                "summary": "Use resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource tracker ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resource and amount",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UseResourceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResourceTracker"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/traits": {
+            "get": {
+                "description": "Return all registered traits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "List all traits",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Trait"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new trait",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "Create trait",
+                "parameters": [
+                    {
+                        "description": "Trait info",
+                        "name": "trait",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Trait"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Trait"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/traits/{id}": {
+            "get": {
+                "description": "Retrieve a trait using the provided ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "Get trait by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Trait"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing trait",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "Update trait",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Trait info",
+                        "name": "trait",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Trait"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Trait"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing trait. Traits still assigned to a race or subrace are only deleted when force is true.",
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "Delete trait",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also remove the trait from every race and subrace using it",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/traits/{id}/races": {
+            "get": {
+                "description": "Return every race the trait is assigned to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Traits"
+                ],
+                "summary": "List races with trait",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trait ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Race"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperror.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dice.DieResult": {
+            "type": "object",
+            "properties": {
+                "kept": {
+                    "type": "boolean"
+                },
+                "rolls": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dice.Outcome": {
+            "type": "object",
+            "properties": {
+                "probability": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dice.Stats": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dice.Outcome"
+                    }
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "notation": {
+                    "type": "string"
+                },
+                "standard_deviation": {
+                    "type": "number"
+                }
+            }
+        },
+        "dice.TermResult": {
+            "type": "object",
+            "properties": {
+                "dice": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dice.DieResult"
+                    }
+                },
+                "sign": {
+                    "type": "integer"
+                },
+                "subtotal": {
+                    "type": "integer"
+                },
+                "term": {
+                    "type": "string"
+                }
+            }
+        },
+        "httperror.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error_code": {
+                    "type": "string"
+                },
+                "error_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AbilityBonusChoice": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "exclude": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "charisma"
+                    ]
+                },
+                "from": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.AbilityBonusPick": {
+            "type": "object",
+            "required": [
+                "abilities",
+                "source"
+            ],
+            "properties": {
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "race",
+                        "subrace"
+                    ]
+                }
+            }
+        },
+        "models.AbilityBonusSelection": {
+            "type": "object",
+            "properties": {
+                "picks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusPick"
+                    }
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.AbilityIncrease": {
+            "type": "object",
+            "required": [
+                "ability",
+                "amount"
+            ],
+            "properties": {
+                "ability": {
+                    "type": "string",
+                    "enum": [
+                        "strength",
+                        "dexterity",
+                        "constitution",
+                        "intelligence",
+                        "wisdom",
+                        "charisma"
+                    ],
+                    "example": "strength"
+                },
+                "amount": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.AbilityRoll": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "type": "string"
+                },
+                "dice": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "dropped": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AbilityScoreBonuses": {
+            "type": "object",
+            "properties": {
+                "charisma": {
+                    "type": "integer"
+                },
+                "constitution": {
+                    "type": "integer"
+                },
+                "dexterity": {
+                    "type": "integer"
+                },
+                "intelligence": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "wisdom": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AbilityScoreSet": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "point_buy",
+                        "standard_array",
+                        "roll"
+                    ]
+                },
+                "modifiers": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "points_spent": {
+                    "type": "integer"
+                },
+                "rolls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityRoll"
+                    }
+                },
+                "scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AbilityScores": {
+            "type": "object",
+            "properties": {
+                "charisma": {
+                    "type": "integer"
+                },
+                "constitution": {
+                    "type": "integer"
+                },
+                "dexterity": {
+                    "type": "integer"
+                },
+                "intelligence": {
+                    "type": "integer"
+                },
+                "strength": {
+                    "type": "integer"
+                },
+                "wisdom": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AbilityScoresRequest": {
+            "type": "object",
+            "required": [
+                "scores"
+            ],
+            "properties": {
+                "scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                }
+            }
+        },
+        "models.ActiveEffect": {
+            "type": "object",
+            "properties": {
+                "concentration": {
+                    "type": "boolean"
+                },
+                "condition": {
+                    "$ref": "#/definitions/models.Condition"
+                },
+                "condition_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "duration": {
+                    "$ref": "#/definitions/models.Duration"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mechanics": {
+                    "$ref": "#/definitions/models.Mechanics"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Restrained"
+                },
+                "remaining_rounds": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "source_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "target_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AdvanceTimeRequest": {
+            "type": "object",
+            "properties": {
+                "hours": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "rounds": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "target_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.AdvanceTimeResult": {
+            "type": "object",
+            "properties": {
+                "expired": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActiveEffect"
+                    }
+                },
+                "rounds": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.Age": {
+            "type": "object",
+            "properties": {
+                "average_lifespan": {
+                    "type": "string"
+                },
+                "maximum_age": {
+                    "type": "integer"
+                },
+                "minimum_age": {
+                    "type": "integer"
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "models.ApplyEffectRequest": {
+            "type": "object",
+            "required": [
+                "duration",
+                "target_ids"
+            ],
+            "properties": {
+                "concentration": {
+                    "type": "boolean"
+                },
+                "condition_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "duration": {
+                    "$ref": "#/definitions/models.Duration"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mechanics": {
+                    "$ref": "#/definitions/models.Mechanics"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Slow"
+                },
+                "source_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "target_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ApplyEffectResult": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActiveEffect"
+                    }
+                },
+                "ended": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActiveEffect"
+                    }
+                }
+            }
+        },
+        "models.ArmorClass": {
+            "type": "object",
+            "properties": {
+                "shield": {
+                    "type": "boolean"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "unarmored",
+                        "unarmored_defense",
+                        "armor"
+                    ],
+                    "example": "armor"
+                },
+                "value": {
+                    "type": "integer",
+                    "example": 16
+                }
+            }
+        },
+        "models.ArmorStats": {
+            "type": "object",
+            "properties": {
+                "base_ac": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "max_dex_bonus": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "stealth_disadvantage": {
+                    "type": "boolean"
+                },
+                "strength_requirement": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "light",
+                        "medium",
+                        "heavy",
+                        "shield"
+                    ],
+                    "example": "medium"
+                }
+            }
+        },
+        "models.Background": {
+            "type": "object",
+            "properties": {
+                "bonds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "feature_description": {
+                    "type": "string"
+                },
+                "feature_name": {
+                    "type": "string",
+                    "example": "Shelter of the Faithful"
+                },
+                "flaws": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ideals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "language_choices": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Language"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acolyte"
+                },
+                "personality_traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "skill_proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "starting_equipment": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tool_proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                }
+            }
+        },
+        "models.BackgroundPersonality": {
+            "type": "object",
+            "properties": {
+                "background_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "bond": {
+                    "type": "string"
+                },
+                "flaw": {
+                    "type": "string"
+                },
+                "ideal": {
+                    "type": "string"
+                },
+                "personality_traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.BonusContribution": {
+            "type": "object",
+            "properties": {
+                "choice": {
+                    "type": "boolean"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "race",
+                        "subrace"
+                    ]
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Character": {
+            "type": "object",
+            "properties": {
+                "ability_bonus_picks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusPick"
+                    }
+                },
+                "ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "advancement_mode": {
+                    "type": "string",
+                    "enum": [
+                        "experience",
+                        "milestone"
+                    ]
+                },
+                "alignment": {
+                    "type": "string"
+                },
+                "armor_class": {
+                    "type": "integer"
+                },
+                "background_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "base_ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "bond": {
+                    "type": "string"
+                },
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "equipment": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "experience_points": {
+                    "type": "integer"
+                },
+                "feats": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "flaw": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ideal": {
+                    "type": "string"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Language"
+                    }
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "max_hit_points": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "personality_traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "models.CharacterDraft": {
+            "type": "object",
+            "properties": {
+                "ability_bonus_picks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusPick"
+                    }
+                },
+                "ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "alignment": {
+                    "type": "string"
+                },
+                "armor_class": {
+                    "type": "integer"
+                },
+                "background_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "bond": {
+                    "type": "string"
+                },
+                "character_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "completed_steps": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "equipment": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "flaw": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ideal": {
+                    "type": "string"
+                },
+                "max_hit_points": {
+                    "type": "integer"
+                },
+                "missing_steps": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "personality_traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "requires_spells": {
+                    "type": "boolean"
+                },
+                "skill_proficiency_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "in_progress",
+                        "finalized"
+                    ]
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CharacterLevelUp": {
+            "type": "object",
+            "properties": {
+                "ability_increases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityIncrease"
+                    }
+                },
+                "character_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "feat": {
+                    "type": "string"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LevelFeature"
+                    }
+                },
+                "hit_die": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "hit_die_result": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "hit_point_method": {
+                    "type": "string",
+                    "enum": [
+                        "average",
+                        "roll"
+                    ]
+                },
+                "hit_points_gained": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Class": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClassFeature"
+                    }
+                },
+                "hit_die": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "multiclass_prerequisites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MulticlassPrerequisite"
+                    }
+                },
+                "multiclass_proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "multiclass_skill_choices": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary_ability": {
+                    "type": "string",
+                    "example": "strength"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "saving_throw_proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strength",
+                        "constitution"
+                    ]
+                },
+                "spellcasting": {
+                    "$ref": "#/definitions/models.ClassSpellcasting"
+                }
+            }
+        },
+        "models.ClassFeature": {
+            "type": "object",
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Extra Attack"
+                }
+            }
+        },
+        "models.ClassLevel": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClassFeature"
+                    }
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "proficiency_bonus": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.ClassLevels": {
+            "type": "object",
+            "required": [
+                "class_id",
+                "level"
+            ],
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.ClassSpellList": {
+            "type": "object",
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "max_spell_level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Spell"
+                    }
+                }
+            }
+        },
+        "models.ClassSpellcasting": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "type": "string",
+                    "example": "intelligence"
+                },
+                "caster_type": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "full",
+                        "half",
+                        "third",
+                        "pact"
+                    ],
+                    "example": "none"
+                }
+            }
+        },
+        "models.Condition": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Mechanics"
+                    }
+                },
+                "mechanics": {
+                    "$ref": "#/definitions/models.Mechanics"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Restrained"
+                }
+            }
+        },
+        "models.ConsumeSlotRequest": {
+            "type": "object",
+            "required": [
+                "spell_level"
+            ],
+            "properties": {
+                "pact": {
+                    "type": "boolean"
+                },
+                "slot_level": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "spell_level": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "upcast": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.Cost": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "currency": {
+                    "type": "string",
+                    "enum": [
+                        "cp",
+                        "sp",
+                        "gp"
+                    ],
+                    "example": "gp"
+                }
+            }
+        },
+        "models.DerivedStats": {
+            "type": "object",
+            "properties": {
+                "ability_modifiers": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "armor_class": {
+                    "$ref": "#/definitions/models.ArmorClass"
+                },
+                "initiative": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "max_hit_points": {
+                    "type": "integer",
+                    "example": 44
+                },
+                "passive_perception": {
+                    "type": "integer",
+                    "example": 13
+                },
+                "proficiency_bonus": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SkillModifier"
+                    }
+                },
+                "speed": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "stealth_disadvantage": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.DerivedStatsRequest": {
+            "type": "object",
+            "required": [
+                "ability_scores",
+                "hit_die",
+                "level",
+                "race_id"
+            ],
+            "properties": {
+                "ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "armor_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "hit_die": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "shield_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "skill_proficiency_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "unarmored_defense": {
+                    "type": "string",
+                    "enum": [
+                        "barbarian",
+                        "monk"
+                    ]
+                }
+            }
+        },
+        "models.DiceRoll": {
+            "type": "object",
+            "properties": {
+                "notation": {
+                    "type": "string"
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "terms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dice.TermResult"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DiceRollRequest": {
+            "type": "object",
+            "required": [
+                "expression"
+            ],
+            "properties": {
+                "expression": {
+                    "type": "string",
+                    "example": "4d6kh3"
+                },
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DiceStatsRequest": {
+            "type": "object",
+            "required": [
+                "expression"
+            ],
+            "properties": {
+                "expression": {
+                    "type": "string",
+                    "example": "2d6+3"
+                }
+            }
+        },
+        "models.DraftBackgroundStep": {
+            "type": "object",
+            "required": [
+                "background_id"
+            ],
+            "properties": {
+                "background_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.DraftClassStep": {
+            "type": "object",
+            "required": [
+                "class_id"
+            ],
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.DraftDescriptionStep": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "alignment": {
+                    "type": "string"
+                },
+                "bond": {
+                    "type": "string"
+                },
+                "flaw": {
+                    "type": "string"
+                },
+                "ideal": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "personality_traits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.DraftEquipmentStep": {
+            "type": "object",
+            "required": [
+                "equipment"
+            ],
+            "properties": {
+                "equipment": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.DraftRaceStep": {
+            "type": "object",
+            "required": [
+                "race_id"
+            ],
+            "properties": {
+                "ability_bonus_picks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusPick"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.DraftSkillsStep": {
+            "type": "object",
+            "required": [
+                "proficiency_ids"
+            ],
+            "properties": {
+                "proficiency_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.DraftSpellsStep": {
+            "type": "object",
+            "required": [
+                "spells"
+            ],
+            "properties": {
+                "spells": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.Duration": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "rounds",
+                        "minutes",
+                        "hours",
+                        "until_removed"
+                    ],
+                    "example": "minutes"
+                }
+            }
+        },
+        "models.EffectiveAbilityBonus": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "type": "string",
+                    "example": "dexterity"
+                },
+                "contributions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BonusContribution"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.EffectiveAge": {
+            "type": "object",
+            "properties": {
+                "average_lifespan": {
+                    "type": "string"
+                },
+                "maximum_age": {
+                    "type": "integer"
+                },
+                "minimum_age": {
+                    "type": "integer"
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "race"
+                    ]
+                }
+            }
+        },
+        "models.EffectiveLanguage": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "script": {
+                    "type": "string",
+                    "example": "Elvish"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "standard",
+                        "exotic"
+                    ],
+                    "example": "standard"
+                },
+                "typical_speakers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.EffectiveProficiency": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "type": "string",
+                    "enum": [
+                        "strength",
+                        "dexterity",
+                        "constitution",
+                        "intelligence",
+                        "wisdom",
+                        "charisma"
+                    ],
+                    "example": "dexterity"
+                },
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "skill",
+                        "tool",
+                        "weapon",
+                        "armor",
+                        "saving_throw"
+                    ],
+                    "example": "skill"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.EffectiveRace": {
+            "type": "object",
+            "properties": {
+                "ability_bonus_choices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SourcedAbilityBonusChoice"
+                    }
+                },
+                "ability_score_bonuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveAbilityBonus"
+                    }
+                },
+                "age": {
+                    "$ref": "#/definitions/models.EffectiveAge"
+                },
+                "alignment": {
+                    "$ref": "#/definitions/models.EffectiveString"
+                },
+                "description": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveString"
+                    }
+                },
+                "languages_known": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveLanguage"
+                    }
+                },
+                "name": {
+                    "$ref": "#/definitions/models.EffectiveString"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveProficiency"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "size": {
+                    "$ref": "#/definitions/models.EffectiveString"
+                },
+                "speed": {
+                    "$ref": "#/definitions/models.EffectiveSpeed"
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveTrait"
+                    }
+                }
+            }
+        },
+        "models.EffectiveSpeed": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "race",
+                        "subrace"
+                    ]
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.EffectiveStats": {
+            "type": "object",
+            "properties": {
+                "base_size": {
+                    "type": "string",
+                    "example": "Medium"
+                },
+                "base_speed": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "effects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ActiveEffect"
+                    }
+                },
+                "mechanics": {
+                    "$ref": "#/definitions/models.Mechanics"
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "size": {
+                    "type": "string",
+                    "example": "Medium"
+                },
+                "speed": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "target_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.EffectiveString": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "race",
+                        "subrace"
+                    ]
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EffectiveTrait": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "limited_use": {
+                    "$ref": "#/definitions/models.LimitedUse"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.Equipment": {
+            "type": "object",
+            "properties": {
+                "armor": {
+                    "$ref": "#/definitions/models.ArmorStats"
+                },
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "adventuring_gear",
+                        "weapon",
+                        "armor",
+                        "tool",
+                        "mount",
+                        "trade_good"
+                    ],
+                    "example": "weapon"
+                },
+                "cost": {
+                    "$ref": "#/definitions/models.Cost"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Longsword"
+                },
+                "weapon": {
+                    "$ref": "#/definitions/models.WeaponStats"
+                },
+                "weight": {
+                    "type": "number",
+                    "example": 3
+                }
+            }
+        },
+        "models.ExperienceAward": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 300
+                }
+            }
+        },
+        "models.HitDicePool": {
+            "type": "object",
+            "required": [
+                "count",
+                "die"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "die": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "models.Language": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "script": {
+                    "type": "string",
+                    "example": "Elvish"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "standard",
+                        "exotic"
+                    ],
+                    "example": "standard"
+                },
+                "typical_speakers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.LanguageSpeakers": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "$ref": "#/definitions/models.Language"
+                },
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Race"
+                    }
+                },
+                "subraces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Subrace"
+                    }
+                }
+            }
+        },
+        "models.LevelFeature": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Extra Attack"
+                }
+            }
+        },
+        "models.LevelUpPreview": {
+            "type": "object",
+            "properties": {
+                "ability_score_improvement": {
+                    "type": "boolean"
+                },
+                "advancement_mode": {
+                    "type": "string",
+                    "enum": [
+                        "experience",
+                        "milestone"
+                    ]
+                },
+                "average_hit_points": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "character_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "eligible": {
+                    "type": "boolean"
+                },
+                "experience_points": {
+                    "type": "integer",
+                    "example": 6500
+                },
+                "experience_required": {
+                    "type": "integer",
+                    "example": 6500
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.LevelFeature"
+                    }
+                },
+                "hit_die": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.LevelUpRequest": {
+            "type": "object",
+            "properties": {
+                "ability_increases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityIncrease"
+                    }
+                },
+                "feat": {
+                    "type": "string",
+                    "example": "Alert"
+                },
+                "hit_points": {
+                    "type": "string",
+                    "enum": [
+                        "average",
+                        "roll"
+                    ],
+                    "example": "average"
+                },
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LimitedUse": {
+            "type": "object",
+            "properties": {
+                "recharge": {
+                    "type": "string",
+                    "enum": [
+                        "short_rest",
+                        "long_rest",
+                        "dawn",
+                        "recharge_roll"
+                    ],
+                    "example": "short_rest"
+                },
+                "recharge_on": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.Mechanics": {
+            "type": "object",
+            "properties": {
+                "ability_checks": {
+                    "type": "string",
+                    "enum": [
+                        "advantage",
+                        "disadvantage",
+                        "normal"
+                    ],
+                    "example": "disadvantage"
+                },
+                "attack_rolls": {
+                    "type": "string",
+                    "enum": [
+                        "advantage",
+                        "disadvantage",
+                        "normal"
+                    ],
+                    "example": "disadvantage"
+                },
+                "attacks_against": {
+                    "type": "string",
+                    "enum": [
+                        "advantage",
+                        "disadvantage",
+                        "normal"
+                    ],
+                    "example": "advantage"
+                },
+                "auto_fail_saves": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strength"
+                    ]
+                },
+                "critical_within_5_feet": {
+                    "type": "boolean"
+                },
+                "dead": {
+                    "type": "boolean"
+                },
+                "halved_hit_point_maximum": {
+                    "type": "boolean"
+                },
+                "incapacitated": {
+                    "type": "boolean"
+                },
+                "resistance_to_all_damage": {
+                    "type": "boolean"
+                },
+                "save_disadvantage": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "dexterity"
+                    ]
+                },
+                "size_change": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "speed": {
+                    "type": "string",
+                    "enum": [
+                        "halved",
+                        "zero"
+                    ],
+                    "example": "zero"
+                },
+                "speed_modifier": {
+                    "type": "integer",
+                    "example": -10
+                }
+            }
+        },
+        "models.Multiclass": {
+            "type": "object",
+            "properties": {
+                "ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "caster_level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "eligible": {
+                    "type": "boolean"
+                },
+                "pact_magic": {
+                    "$ref": "#/definitions/models.PactSlots"
+                },
+                "prerequisites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PrerequisiteCheck"
+                    }
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MulticlassProficiencies"
+                    }
+                },
+                "proficiency_bonus": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "spell_slots": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        4,
+                        3,
+                        2
+                    ]
+                },
+                "total_level": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "models.MulticlassPrerequisite": {
+            "type": "object",
+            "properties": {
+                "abilities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "strength",
+                        "dexterity"
+                    ]
+                },
+                "minimum": {
+                    "type": "integer",
+                    "example": 13
+                }
+            }
+        },
+        "models.MulticlassProficiencies": {
+            "type": "object",
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "class_name": {
+                    "type": "string",
+                    "example": "Rogue"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "skill_choices": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.MulticlassRequest": {
+            "type": "object",
+            "required": [
+                "ability_scores",
+                "classes",
+                "race_id"
+            ],
+            "properties": {
+                "ability_scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "classes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.ClassLevels"
+                    }
+                },
+                "picks": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.PactMagic": {
+            "type": "object",
+            "properties": {
+                "slot_level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "slots": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "models.PactSlots": {
+            "type": "object",
+            "properties": {
+                "slot_level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "slots": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.PrerequisiteCheck": {
+            "type": "object",
+            "properties": {
+                "class_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "class_name": {
+                    "type": "string",
+                    "example": "Fighter"
+                },
+                "met": {
+                    "type": "boolean"
+                },
+                "requirement": {
+                    "type": "string",
+                    "example": "strength 13 or dexterity 13"
+                }
+            }
+        },
+        "models.Proficiency": {
+            "type": "object",
+            "properties": {
+                "ability": {
+                    "type": "string",
+                    "enum": [
+                        "strength",
+                        "dexterity",
+                        "constitution",
+                        "intelligence",
+                        "wisdom",
+                        "charisma"
+                    ],
+                    "example": "dexterity"
+                },
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "skill",
+                        "tool",
+                        "weapon",
+                        "armor",
+                        "saving_throw"
+                    ],
+                    "example": "skill"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Race": {
+            "type": "object",
+            "properties": {
+                "ability_bonus_choices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusChoice"
+                    }
+                },
+                "ability_score_bonuses": {
+                    "$ref": "#/definitions/models.AbilityScoreBonuses"
+                },
+                "age": {
+                    "$ref": "#/definitions/models.Age"
+                },
+                "alignment": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "languages_known": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Language"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "size": {
+                    "type": "string"
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "subraces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Subrace"
+                    }
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Trait"
+                    }
+                }
+            }
+        },
+        "models.RacialAbilityScores": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "bonuses": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "modifiers": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                }
+            }
+        },
+        "models.RacialAbilityScoresRequest": {
+            "type": "object",
+            "required": [
+                "race_id",
+                "scores"
+            ],
+            "properties": {
+                "picks": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "scores": {
+                    "$ref": "#/definitions/models.AbilityScores"
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.RechargeRequest": {
+            "type": "object",
+            "properties": {
+                "seed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RechargeRoll": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Breath Weapon"
+                },
+                "recharged": {
+                    "type": "boolean"
+                },
+                "roll": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.Recovery": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Ki"
+                },
+                "recovered": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.ResolvedAbilityBonuses": {
+            "type": "object",
+            "properties": {
+                "ability_score_bonuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EffectiveAbilityBonus"
+                    }
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.Resource": {
+            "type": "object",
+            "properties": {
+                "die": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "max": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ki"
+                },
+                "recharge": {
+                    "type": "string",
+                    "enum": [
+                        "short_rest",
+                        "long_rest",
+                        "dawn",
+                        "recharge_roll"
+                    ],
+                    "example": "short_rest"
+                },
+                "recharge_on": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "custom",
+                        "trait",
+                        "hit_dice"
+                    ],
+                    "example": "custom"
+                },
+                "trait_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.ResourceTracker": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "owner_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Resource"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ResourceTrackerRequest": {
+            "type": "object",
+            "required": [
+                "owner_id"
+            ],
+            "properties": {
+                "hit_dice": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HitDicePool"
+                    }
+                },
+                "owner_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Resource"
+                    }
+                },
+                "subrace_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "models.RestResult": {
+            "type": "object",
+            "properties": {
+                "hit_dice_rolls": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "hit_points_regained": {
+                    "type": "integer"
+                },
+                "recharge_rolls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RechargeRoll"
+                    }
+                },
+                "recovered": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Recovery"
+                    }
+                },
+                "seed": {
+                    "type": "integer"
+                },
+                "tracker": {
+                    "$ref": "#/definitions/models.ResourceTracker"
+                }
+            }
+        },
+        "models.RestoreSlotRequest": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "level": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "pact": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.SearchHit": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "\u003cmark\u003eHill\u003c/mark\u003e \u003cmark\u003eDwarf\u003c/mark\u003e"
+                },
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "rank": {
+                    "type": "number",
+                    "example": 0.87
+                },
+                "snippet": {
+                    "type": "string"
                 }
             }
         },
-        "models.AbilityScoreBonuses": {
+        "models.SearchResults": {
             "type": "object",
             "properties": {
-                "charisma": {
-                    "type": "integer"
+                "query": {
+                    "type": "string",
+                    "example": "dwarf hill"
                 },
-                "constitution": {
-                    "type": "integer"
+                "races": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchHit"
+                    }
                 },
-                "dexterity": {
-                    "type": "integer"
+                "subraces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchHit"
+                    }
                 },
-                "intelligence": {
-                    "type": "integer"
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchHit"
+                    }
+                }
+            }
+        },
+        "models.ShortRestRequest": {
+            "type": "object",
+            "properties": {
+                "con_modifier": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "strength": {
-                    "type": "integer"
+                "die": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "wisdom": {
+                "hit_dice": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "seed": {
                     "type": "integer"
                 }
             }
         },
-        "models.Age": {
+        "models.SkillModifier": {
             "type": "object",
             "properties": {
-                "average_lifespan": {
-                    "type": "string"
+                "ability": {
+                    "type": "string",
+                    "example": "wisdom"
                 },
-                "maximum_age": {
-                    "type": "integer"
+                "modifier": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "minimum_age": {
+                "name": {
+                    "type": "string",
+                    "example": "Perception"
+                },
+                "proficiency_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "proficient": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.SlotConsumption": {
+            "type": "object",
+            "properties": {
+                "pact": {
+                    "type": "boolean"
+                },
+                "slot_level": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "spell_level": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "tracker": {
+                    "$ref": "#/definitions/models.SpellSlotTracker"
+                }
+            }
+        },
+        "models.SlotPool": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "max": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.SourcedAbilityBonusChoice": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "exclude": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "charisma"
+                    ]
+                },
+                "from": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "index": {
                     "type": "integer"
                 },
-                "race_id": {
+                "source": {
                     "type": "string",
-                    "format": "uuid",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "enum": [
+                        "race",
+                        "subrace"
+                    ]
                 }
             }
         },
-        "models.Language": {
+        "models.Spell": {
             "type": "object",
             "properties": {
+                "casting_time": {
+                    "type": "string",
+                    "example": "1 action"
+                },
+                "classes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Class"
+                    }
+                },
+                "components": {
+                    "$ref": "#/definitions/models.SpellComponents"
+                },
+                "concentration": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "Instantaneous"
+                },
+                "higher_levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SpellScaling"
+                    }
+                },
                 "id": {
                     "type": "string",
                     "format": "uuid",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
+                "level": {
+                    "type": "integer",
+                    "example": 3
+                },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Fireball"
+                },
+                "range": {
+                    "type": "string",
+                    "example": "150 feet"
+                },
+                "ritual": {
+                    "type": "boolean"
+                },
+                "school": {
+                    "type": "string",
+                    "enum": [
+                        "abjuration",
+                        "conjuration",
+                        "divination",
+                        "enchantment",
+                        "evocation",
+                        "illusion",
+                        "necromancy",
+                        "transmutation"
+                    ],
+                    "example": "evocation"
                 }
             }
         },
-        "models.Proficiency": {
+        "models.SpellComponents": {
+            "type": "object",
+            "properties": {
+                "material": {
+                    "type": "boolean"
+                },
+                "material_consumed": {
+                    "type": "boolean"
+                },
+                "material_cost": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "materials": {
+                    "type": "string",
+                    "example": "a tiny ball of bat guano and sulfur"
+                },
+                "somatic": {
+                    "type": "boolean"
+                },
+                "verbal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.SpellScaling": {
             "type": "object",
             "properties": {
+                "character_level": {
+                    "type": "integer"
+                },
+                "damage": {
+                    "type": "string",
+                    "example": "9d6"
+                },
                 "description": {
                     "type": "string"
                 },
+                "slot_level": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "models.SpellSlotTracker": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string",
                     "format": "uuid",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "name": {
+                "owner_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "pact_magic": {
+                    "$ref": "#/definitions/models.PactMagic"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SlotPool"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.Race": {
+        "models.SpellSlotTrackerRequest": {
             "type": "object",
+            "required": [
+                "owner_id"
+            ],
             "properties": {
-                "ability_score_bonuses": {
-                    "$ref": "#/definitions/models.AbilityScoreBonuses"
+                "caster_level": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "age": {
-                    "$ref": "#/definitions/models.Age"
+                "owner_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "alignment": {
-                    "type": "string"
+                "pact_level": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "pact_slot_level": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "pact_slots": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        4,
+                        3,
+                        2
+                    ]
+                }
+            }
+        },
+        "models.Subrace": {
+            "type": "object",
+            "properties": {
+                "ability_bonus_choices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusChoice"
+                    }
+                },
+                "ability_score_bonuses": {
+                    "$ref": "#/definitions/models.AbilityScoreBonuses"
                 },
                 "description": {
                     "type": "string"
@@ -593,18 +9011,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/models.Proficiency"
                     }
                 },
-                "size": {
-                    "type": "string"
+                "race_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
                 "speed": {
                     "type": "integer"
                 },
-                "subraces": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Subrace"
-                    }
-                },
                 "traits": {
                     "type": "array",
                     "items": {
@@ -613,27 +9027,66 @@ const docTemplate = `{
                 }
             }
         },
-        "models.Subrace": {
+        "models.SubracePatch": {
             "type": "object",
             "properties": {
+                "ability_bonus_choices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AbilityBonusChoice"
+                    }
+                },
                 "ability_score_bonuses": {
                     "$ref": "#/definitions/models.AbilityScoreBonuses"
                 },
                 "description": {
                     "type": "string"
                 },
+                "languages_known": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Language"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Proficiency"
+                    }
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "traits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Trait"
+                    }
+                }
+            }
+        },
+        "models.Suggestion": {
+            "type": "object",
+            "properties": {
                 "id": {
                     "type": "string",
                     "format": "uuid",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Darkvision"
                 },
-                "race_id": {
+                "score": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "type": {
                     "type": "string",
-                    "format": "uuid",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "trait"
                 }
             }
         },
@@ -648,10 +9101,77 @@ const docTemplate = `{
                     "format": "uuid",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
+                "limited_use": {
+                    "$ref": "#/definitions/models.LimitedUse"
+                },
                 "name": {
                     "type": "string"
                 }
             }
+        },
+        "models.UseResourceRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ki"
+                }
+            }
+        },
+        "models.WeaponStats": {
+            "type": "object",
+            "properties": {
+                "damage": {
+                    "type": "string",
+                    "example": "1d8"
+                },
+                "damage_type": {
+                    "type": "string",
+                    "example": "slashing"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "simple",
+                        "martial"
+                    ],
+                    "example": "martial"
+                },
+                "long_range": {
+                    "type": "integer"
+                },
+                "normal_range": {
+                    "type": "integer"
+                },
+                "properties": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "versatile"
+                    ]
+                },
+                "range": {
+                    "type": "string",
+                    "enum": [
+                        "melee",
+                        "ranged"
+                    ],
+                    "example": "melee"
+                },
+                "versatile_damage": {
+                    "type": "string",
+                    "example": "1d10"
+                }
+            }
         }
     }
 }`
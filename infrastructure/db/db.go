@@ -5,7 +5,15 @@ import (
 	"fmt"
 	"sync"
 
+	backgroundModels "github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	characterModels "github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	conditionModels "github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	equipmentModels "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	resourceModels "github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	spellModels "github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	spellSlotModels "github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -63,17 +71,6 @@ func (f *FactoryDB) CreatePostgresConnection(name, dsn string) (DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	if err := db.AutoMigrate(
-		&models.Race{},
-		&models.Age{},
-		&models.Trait{},
-		&models.Subrace{},
-		&models.Language{},
-		&models.Proficiency{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to migrate database: %w", err)
-	}
-
 	connection := &dbImpl{conn: db}
 	f.connections[name] = connection
 	return connection, nil
@@ -86,3 +83,37 @@ func (f *FactoryDB) GetConnection(name string) (DB, bool) {
 	conn, exists := f.connections[name]
 	return conn, exists
 }
+
+// AutoMigrate creates and alters the tables of every model with GORM. It is meant for development only: it cannot
+// drop or rename columns nor be rolled back, and replicas starting together race each other. Production schemas
+// are managed by the versioned migrations of the migrations package.
+func AutoMigrate(conn DB) error {
+	// Search needs pg_trgm. The search indexes themselves only come with the versioned migrations.
+	if err := conn.GetDB().Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("failed to enable pg_trgm: %w", err)
+	}
+
+	if err := conn.GetDB().AutoMigrate(
+		&models.Race{},
+		&models.Age{},
+		&models.Trait{},
+		&models.Subrace{},
+		&models.Language{},
+		&models.Proficiency{},
+		&characterModels.Character{},
+		&characterModels.CharacterDraft{},
+		&characterModels.CharacterLevelUp{},
+		&classModels.Class{},
+		&classModels.ClassFeature{},
+		&backgroundModels.Background{},
+		&spellModels.Spell{},
+		&spellSlotModels.SpellSlotTracker{},
+		&resourceModels.ResourceTracker{},
+		&conditionModels.Condition{},
+		&conditionModels.ActiveEffect{},
+		&equipmentModels.Equipment{},
+	); err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+	return nil
+}
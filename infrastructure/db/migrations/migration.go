@@ -0,0 +1,125 @@
+// Package migrations applies the versioned SQL migrations of the database schema.
+package migrations
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+//go:embed sql/*.sql
+var embedded embed.FS
+
+// migrationFile matches the file names of migrations, such as 0002_add_feats.up.sql.
+var migrationFile = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// migrationName matches the names accepted for new migrations.
+var migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Migration is one numbered schema change with the SQL applying and reverting it. Checksum identifies the up SQL
+// so a migration edited after it was applied is detected.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Embedded returns the migrations compiled into the binary.
+func Embedded() ([]Migration, error) {
+	source, err := fs.Sub(embedded, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return Load(source)
+}
+
+// Load reads the migrations of source ordered by version. Every version needs both an up and a down file.
+func Load(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+		content, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return int(a.Version - b.Version)
+	})
+	return migrations, nil
+}
+
+// Create writes empty up and down files for a new migration to dir, numbered after the latest migration there, and
+// returns their paths.
+func Create(dir string, name string) (string, string, error) {
+	if !migrationName.MatchString(name) {
+		return "", "", fmt.Errorf("migration names may only use lowercase letters, digits and underscores: %s", name)
+	}
+
+	existing, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	version := int64(1)
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", version, name))
+	header := fmt.Sprintf("-- %04d_%s, created %s\n", version, name, time.Now().UTC().Format(time.DateOnly))
+	paths := []string{base + ".up.sql", base + ".down.sql"}
+	for _, path := range paths {
+		if err := os.WriteFile(path, []byte(header), 0o644); err != nil {
+			return "", "", fmt.Errorf("failed to create migration: %w", err)
+		}
+	}
+	return paths[0], paths[1], nil
+}
@@ -0,0 +1,32 @@
+DROP TABLE IF EXISTS "active_effects";
+DROP TABLE IF EXISTS "conditions";
+DROP TABLE IF EXISTS "resource_trackers";
+DROP TABLE IF EXISTS "equipment";
+DROP TABLE IF EXISTS "spell_slot_trackers";
+DROP TABLE IF EXISTS "spell_classes";
+DROP TABLE IF EXISTS "spells";
+DROP TABLE IF EXISTS "background_languages";
+DROP TABLE IF EXISTS "background_skill_proficiencies";
+DROP TABLE IF EXISTS "background_tool_proficiencies";
+DROP TABLE IF EXISTS "backgrounds";
+DROP TABLE IF EXISTS "class_features";
+DROP TABLE IF EXISTS "class_proficiencies";
+DROP TABLE IF EXISTS "class_multiclass_proficiencies";
+DROP TABLE IF EXISTS "classes";
+DROP TABLE IF EXISTS "character_level_ups";
+DROP TABLE IF EXISTS "character_drafts";
+DROP TABLE IF EXISTS "character_languages";
+DROP TABLE IF EXISTS "character_proficiencies";
+DROP TABLE IF EXISTS "characters";
+DROP TABLE IF EXISTS "subrace_languages";
+DROP TABLE IF EXISTS "subrace_traits";
+DROP TABLE IF EXISTS "subrace_proficiencies";
+DROP TABLE IF EXISTS "subraces";
+DROP TABLE IF EXISTS "ages";
+DROP TABLE IF EXISTS "race_languages";
+DROP TABLE IF EXISTS "languages";
+DROP TABLE IF EXISTS "race_traits";
+DROP TABLE IF EXISTS "traits";
+DROP TABLE IF EXISTS "race_proficiencies";
+DROP TABLE IF EXISTS "proficiencies";
+DROP TABLE IF EXISTS "races";
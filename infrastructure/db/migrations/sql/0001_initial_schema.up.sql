@@ -0,0 +1,445 @@
+-- Schema of every table created by GORM AutoMigrate before versioned migrations were introduced. The statements
+-- only create what is missing, so databases that were set up by AutoMigrate can be brought under version control by
+-- running this migration on them.
+
+-- Races, subraces and their catalogs
+
+CREATE TABLE IF NOT EXISTS "races" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "strength" bigint,
+    "dexterity" bigint,
+    "constitution" bigint,
+    "intelligence" bigint,
+    "wisdom" bigint,
+    "charisma" bigint,
+    "ability_bonus_choices" jsonb,
+    "size" text,
+    "speed" smallint,
+    "alignment" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_races_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "proficiencies" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "category" text,
+    "ability" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_proficiencies_name" UNIQUE ("name")
+);
+CREATE INDEX IF NOT EXISTS "idx_proficiencies_category" ON "proficiencies" ("category");
+
+CREATE TABLE IF NOT EXISTS "race_proficiencies" (
+    "race_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("race_id","proficiency_id"),
+    CONSTRAINT "fk_race_proficiencies_race" FOREIGN KEY ("race_id") REFERENCES "races"("id"),
+    CONSTRAINT "fk_race_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "traits" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "limited_use" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_traits_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "race_traits" (
+    "race_id" uuid DEFAULT gen_random_uuid(),
+    "trait_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("race_id","trait_id"),
+    CONSTRAINT "fk_race_traits_race" FOREIGN KEY ("race_id") REFERENCES "races"("id"),
+    CONSTRAINT "fk_race_traits_trait" FOREIGN KEY ("trait_id") REFERENCES "traits"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "languages" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "type" text NOT NULL DEFAULT 'standard',
+    "script" text,
+    "typical_speakers" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_languages_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "race_languages" (
+    "race_id" uuid DEFAULT gen_random_uuid(),
+    "language_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("race_id","language_id"),
+    CONSTRAINT "fk_race_languages_language" FOREIGN KEY ("language_id") REFERENCES "languages"("id"),
+    CONSTRAINT "fk_race_languages_race" FOREIGN KEY ("race_id") REFERENCES "races"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "ages" (
+    "race_id" uuid,
+    "average_lifespan" text,
+    "minimum_age" bigint,
+    "maximum_age" bigint,
+    PRIMARY KEY ("race_id"),
+    CONSTRAINT "fk_races_age" FOREIGN KEY ("race_id") REFERENCES "races"("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "subraces" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "race_id" uuid,
+    "name" text NOT NULL,
+    "description" text,
+    "strength" bigint,
+    "dexterity" bigint,
+    "constitution" bigint,
+    "intelligence" bigint,
+    "wisdom" bigint,
+    "charisma" bigint,
+    "ability_bonus_choices" jsonb,
+    "speed" smallint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_races_subraces" FOREIGN KEY ("race_id") REFERENCES "races"("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "subrace_proficiencies" (
+    "subrace_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("subrace_id","proficiency_id"),
+    CONSTRAINT "fk_subrace_proficiencies_subrace" FOREIGN KEY ("subrace_id") REFERENCES "subraces"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_subrace_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "subrace_traits" (
+    "subrace_id" uuid DEFAULT gen_random_uuid(),
+    "trait_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("subrace_id","trait_id"),
+    CONSTRAINT "fk_subrace_traits_subrace" FOREIGN KEY ("subrace_id") REFERENCES "subraces"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_subrace_traits_trait" FOREIGN KEY ("trait_id") REFERENCES "traits"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "subrace_languages" (
+    "subrace_id" uuid DEFAULT gen_random_uuid(),
+    "language_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("subrace_id","language_id"),
+    CONSTRAINT "fk_subrace_languages_subrace" FOREIGN KEY ("subrace_id") REFERENCES "subraces"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_subrace_languages_language" FOREIGN KEY ("language_id") REFERENCES "languages"("id") ON DELETE CASCADE
+);
+
+-- Characters
+
+CREATE TABLE IF NOT EXISTS "characters" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "level" bigint NOT NULL DEFAULT 1,
+    "advancement_mode" text NOT NULL DEFAULT 'experience',
+    "experience_points" bigint NOT NULL DEFAULT 0,
+    "race_id" uuid NOT NULL,
+    "subrace_id" uuid,
+    "class_id" uuid,
+    "background_id" uuid,
+    "alignment" text,
+    "base_strength" bigint,
+    "base_dexterity" bigint,
+    "base_constitution" bigint,
+    "base_intelligence" bigint,
+    "base_wisdom" bigint,
+    "base_charisma" bigint,
+    "strength" bigint,
+    "dexterity" bigint,
+    "constitution" bigint,
+    "intelligence" bigint,
+    "wisdom" bigint,
+    "charisma" bigint,
+    "size" text,
+    "speed" smallint,
+    "max_hit_points" bigint,
+    "armor_class" bigint,
+    "equipment" jsonb,
+    "spells" jsonb,
+    "feats" jsonb,
+    "personality_traits" jsonb,
+    "ideal" text,
+    "bond" text,
+    "flaw" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_characters_class_id" ON "characters" ("class_id");
+CREATE INDEX IF NOT EXISTS "idx_characters_race_id" ON "characters" ("race_id");
+
+CREATE TABLE IF NOT EXISTS "character_proficiencies" (
+    "character_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("character_id","proficiency_id"),
+    CONSTRAINT "fk_character_proficiencies_character" FOREIGN KEY ("character_id") REFERENCES "characters"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_character_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "character_languages" (
+    "character_id" uuid DEFAULT gen_random_uuid(),
+    "language_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("character_id","language_id"),
+    CONSTRAINT "fk_character_languages_character" FOREIGN KEY ("character_id") REFERENCES "characters"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_character_languages_language" FOREIGN KEY ("language_id") REFERENCES "languages"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "character_drafts" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "status" text NOT NULL DEFAULT 'in_progress',
+    "completed_steps" jsonb,
+    "race_id" uuid,
+    "subrace_id" uuid,
+    "class_id" uuid,
+    "requires_spells" boolean,
+    "base_strength" bigint,
+    "base_dexterity" bigint,
+    "base_constitution" bigint,
+    "base_intelligence" bigint,
+    "base_wisdom" bigint,
+    "base_charisma" bigint,
+    "background_id" uuid,
+    "equipment" jsonb,
+    "name" text,
+    "alignment" text,
+    "personality_traits" jsonb,
+    "ideal" text,
+    "bond" text,
+    "flaw" text,
+    "skill_proficiency_ids" jsonb,
+    "max_hit_points" bigint,
+    "armor_class" bigint,
+    "spells" jsonb,
+    "character_id" uuid,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "character_level_ups" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "character_id" uuid NOT NULL,
+    "class_id" uuid NOT NULL,
+    "level" bigint NOT NULL,
+    "hit_point_method" text NOT NULL,
+    "hit_die" bigint,
+    "hit_die_result" bigint,
+    "seed" bigint,
+    "hit_points_gained" bigint,
+    "ability_increases" jsonb,
+    "feat" text,
+    "features" jsonb,
+    "previous_base_strength" bigint,
+    "previous_base_dexterity" bigint,
+    "previous_base_constitution" bigint,
+    "previous_base_intelligence" bigint,
+    "previous_base_wisdom" bigint,
+    "previous_base_charisma" bigint,
+    "previous_strength" bigint,
+    "previous_dexterity" bigint,
+    "previous_constitution" bigint,
+    "previous_intelligence" bigint,
+    "previous_wisdom" bigint,
+    "previous_charisma" bigint,
+    "previous_max_hit_points" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_characters_level_history" FOREIGN KEY ("character_id") REFERENCES "characters"("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_character_level_ups_character_id" ON "character_level_ups" ("character_id");
+
+-- Classes
+
+CREATE TABLE IF NOT EXISTS "classes" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "hit_die" bigint NOT NULL,
+    "primary_ability" text,
+    "saving_throw_proficiencies" jsonb,
+    "spellcasting_caster_type" text NOT NULL DEFAULT 'none',
+    "spellcasting_ability" text,
+    "multiclass_prerequisites" jsonb,
+    "multiclass_skill_choices" bigint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_classes_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "class_multiclass_proficiencies" (
+    "class_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("class_id","proficiency_id"),
+    CONSTRAINT "fk_class_multiclass_proficiencies_class" FOREIGN KEY ("class_id") REFERENCES "classes"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_class_multiclass_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "class_proficiencies" (
+    "class_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("class_id","proficiency_id"),
+    CONSTRAINT "fk_class_proficiencies_class" FOREIGN KEY ("class_id") REFERENCES "classes"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_class_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "class_features" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "class_id" uuid NOT NULL,
+    "level" bigint NOT NULL,
+    "name" text NOT NULL,
+    "description" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_classes_features" FOREIGN KEY ("class_id") REFERENCES "classes"("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_class_features_class_id" ON "class_features" ("class_id");
+
+-- Backgrounds
+
+CREATE TABLE IF NOT EXISTS "backgrounds" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "language_choices" bigint,
+    "starting_equipment" jsonb,
+    "feature_name" text,
+    "feature_description" text,
+    "personality_traits" jsonb,
+    "ideals" jsonb,
+    "bonds" jsonb,
+    "flaws" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_backgrounds_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "background_tool_proficiencies" (
+    "background_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("background_id","proficiency_id"),
+    CONSTRAINT "fk_background_tool_proficiencies_background" FOREIGN KEY ("background_id") REFERENCES "backgrounds"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_background_tool_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "background_skill_proficiencies" (
+    "background_id" uuid DEFAULT gen_random_uuid(),
+    "proficiency_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("background_id","proficiency_id"),
+    CONSTRAINT "fk_background_skill_proficiencies_background" FOREIGN KEY ("background_id") REFERENCES "backgrounds"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_background_skill_proficiencies_proficiency" FOREIGN KEY ("proficiency_id") REFERENCES "proficiencies"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "background_languages" (
+    "background_id" uuid DEFAULT gen_random_uuid(),
+    "language_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("background_id","language_id"),
+    CONSTRAINT "fk_background_languages_background" FOREIGN KEY ("background_id") REFERENCES "backgrounds"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_background_languages_language" FOREIGN KEY ("language_id") REFERENCES "languages"("id") ON DELETE CASCADE
+);
+
+-- Spells
+
+CREATE TABLE IF NOT EXISTS "spells" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "level" bigint NOT NULL,
+    "school" text NOT NULL,
+    "casting_time" text,
+    "range" text,
+    "component_verbal" boolean,
+    "component_somatic" boolean,
+    "component_material" boolean,
+    "component_materials" text,
+    "component_material_cost" bigint,
+    "component_material_consumed" boolean,
+    "duration" text,
+    "concentration" boolean,
+    "ritual" boolean,
+    "description" text,
+    "higher_levels" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_spells_name" UNIQUE ("name")
+);
+CREATE INDEX IF NOT EXISTS "idx_spells_school" ON "spells" ("school");
+CREATE INDEX IF NOT EXISTS "idx_spells_level" ON "spells" ("level");
+
+CREATE TABLE IF NOT EXISTS "spell_classes" (
+    "spell_id" uuid DEFAULT gen_random_uuid(),
+    "class_id" uuid DEFAULT gen_random_uuid(),
+    PRIMARY KEY ("spell_id","class_id"),
+    CONSTRAINT "fk_spell_classes_spell" FOREIGN KEY ("spell_id") REFERENCES "spells"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_spell_classes_class" FOREIGN KEY ("class_id") REFERENCES "classes"("id") ON DELETE CASCADE
+);
+
+-- Spell slot trackers
+
+CREATE TABLE IF NOT EXISTS "spell_slot_trackers" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "owner_id" uuid NOT NULL,
+    "slots" jsonb,
+    "pact_slots" bigint NOT NULL DEFAULT 0,
+    "pact_slot_level" bigint NOT NULL DEFAULT 0,
+    "pact_used" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_spell_slot_trackers_owner_id" ON "spell_slot_trackers" ("owner_id");
+
+-- Equipment
+
+CREATE TABLE IF NOT EXISTS "equipment" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "category" text NOT NULL,
+    "description" text,
+    "weight" decimal,
+    "cost_amount" bigint,
+    "cost_currency" text DEFAULT 'gp',
+    "weapon" jsonb,
+    "armor" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_equipment_name" UNIQUE ("name")
+);
+CREATE INDEX IF NOT EXISTS "idx_equipment_category" ON "equipment" ("category");
+
+-- Resource trackers
+
+CREATE TABLE IF NOT EXISTS "resource_trackers" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "owner_id" uuid NOT NULL,
+    "resources" jsonb,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_resource_trackers_owner_id" ON "resource_trackers" ("owner_id");
+
+-- Conditions and active effects
+
+CREATE TABLE IF NOT EXISTS "conditions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" text NOT NULL,
+    "description" text,
+    "mechanics" jsonb,
+    "levels" jsonb,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_conditions_name" UNIQUE ("name")
+);
+
+CREATE TABLE IF NOT EXISTS "active_effects" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "target_id" uuid NOT NULL,
+    "condition_id" uuid,
+    "name" text NOT NULL,
+    "level" bigint,
+    "mechanics" jsonb,
+    "duration_amount" bigint,
+    "duration_unit" text,
+    "remaining_rounds" bigint,
+    "source_id" uuid,
+    "concentration" boolean,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_active_effects_condition" FOREIGN KEY ("condition_id") REFERENCES "conditions"("id") ON DELETE CASCADE ON UPDATE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_active_effects_source_id" ON "active_effects" ("source_id");
+CREATE INDEX IF NOT EXISTS "idx_active_effects_condition_id" ON "active_effects" ("condition_id");
+CREATE INDEX IF NOT EXISTS "idx_active_effects_target_id" ON "active_effects" ("target_id");
@@ -0,0 +1,8 @@
+-- pg_trgm stays installed, since other objects of the database may use it.
+
+DROP INDEX IF EXISTS "idx_traits_name_trgm";
+DROP INDEX IF EXISTS "idx_traits_search";
+DROP INDEX IF EXISTS "idx_subraces_name_trgm";
+DROP INDEX IF EXISTS "idx_subraces_search";
+DROP INDEX IF EXISTS "idx_races_name_trgm";
+DROP INDEX IF EXISTS "idx_races_search";
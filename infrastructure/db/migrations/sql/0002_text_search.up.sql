@@ -0,0 +1,13 @@
+-- Full-text and trigram search over the names and descriptions of races, subraces and traits. The indexed
+-- expressions must stay identical to those of the search repository for the indexes to be used.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS "idx_races_search" ON "races" USING gin (to_tsvector('english', name || ' ' || coalesce(description, '')));
+CREATE INDEX IF NOT EXISTS "idx_races_name_trgm" ON "races" USING gin (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_subraces_search" ON "subraces" USING gin (to_tsvector('english', name || ' ' || coalesce(description, '')));
+CREATE INDEX IF NOT EXISTS "idx_subraces_name_trgm" ON "subraces" USING gin (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS "idx_traits_search" ON "traits" USING gin (to_tsvector('english', name || ' ' || coalesce(description, '')));
+CREATE INDEX IF NOT EXISTS "idx_traits_name_trgm" ON "traits" USING gin (name gin_trgm_ops);
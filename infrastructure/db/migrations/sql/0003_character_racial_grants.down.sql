@@ -0,0 +1,2 @@
+ALTER TABLE "characters" DROP COLUMN IF EXISTS "racial_proficiency_ids";
+ALTER TABLE "characters" DROP COLUMN IF EXISTS "racial_language_ids";
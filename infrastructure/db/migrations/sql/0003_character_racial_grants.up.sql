@@ -0,0 +1,4 @@
+-- The languages and proficiencies a character's race added, so changing the race removes only those.
+
+ALTER TABLE "characters" ADD COLUMN IF NOT EXISTS "racial_language_ids" jsonb;
+ALTER TABLE "characters" ADD COLUMN IF NOT EXISTS "racial_proficiency_ids" jsonb;
@@ -0,0 +1,2 @@
+ALTER TABLE "character_drafts" DROP COLUMN IF EXISTS "ability_bonus_picks";
+ALTER TABLE "characters" DROP COLUMN IF EXISTS "ability_bonus_picks";
@@ -0,0 +1,4 @@
+-- The answers to the ability bonus choices of a character's race and subrace, and of a draft's.
+
+ALTER TABLE "characters" ADD COLUMN IF NOT EXISTS "ability_bonus_picks" jsonb;
+ALTER TABLE "character_drafts" ADD COLUMN IF NOT EXISTS "ability_bonus_picks" jsonb;
@@ -0,0 +1,12 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type AbilityScoreController interface {
+	PointBuy(ctx *gin.Context)
+	StandardArray(ctx *gin.Context)
+	Roll(ctx *gin.Context)
+	ApplyRacialBonuses(ctx *gin.Context)
+}
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// abilityScoreControllerGin is a concrete implementation of AbilityScoreController using the Gin framework.
+type abilityScoreControllerGin struct {
+	service services.AbilityScoreService
+}
+
+// NewAbilityScoreControllerGin creates a new instance of abilityScoreControllerGin.
+func NewAbilityScoreControllerGin(service services.AbilityScoreService) AbilityScoreController {
+	return &abilityScoreControllerGin{
+		service: service,
+	}
+}
+
+// PointBuy godoc
+// @Summary      Validate point-buy scores
+// @Description  Check base ability scores bought with 27 points, each between 8 and 15, and return their cost and modifiers
+// @Tags         Ability Scores
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.AbilityScoresRequest  true  "Base ability scores"
+// @Success      200      {object}  models.AbilityScoreSet
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Router       /ability-scores/point-buy [post]
+func (c *abilityScoreControllerGin) PointBuy(ctx *gin.Context) {
+	var request models.AbilityScoresRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	set, err := c.service.PointBuy(request.Scores)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, set)
+}
+
+// StandardArray godoc
+// @Summary      Validate standard array assignment
+// @Description  Check that base ability scores assign each standard array value (15, 14, 13, 12, 10, 8) exactly once and return their modifiers
+// @Tags         Ability Scores
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.AbilityScoresRequest  true  "Base ability scores"
+// @Success      200      {object}  models.AbilityScoreSet
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Router       /ability-scores/standard-array [post]
+func (c *abilityScoreControllerGin) StandardArray(ctx *gin.Context) {
+	var request models.AbilityScoresRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	set, err := c.service.StandardArray(request.Scores)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, set)
+}
+
+// Roll godoc
+// @Summary      Roll ability scores
+// @Description  Roll 4d6 and drop the lowest die for each ability, in the order strength, dexterity, constitution, intelligence, wisdom, charisma. The seed used is returned so the roll can be reproduced.
+// @Tags         Ability Scores
+// @Accept       json
+// @Produce      json
+// @Param        seed  query     integer  false  "Seed for a reproducible roll"
+// @Success      200   {object}  models.AbilityScoreSet
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Router       /ability-scores/roll [post]
+func (c *abilityScoreControllerGin) Roll(ctx *gin.Context) {
+	var seed *uint64
+	if seedStr, ok := ctx.GetQuery("seed"); ok {
+		value, err := strconv.ParseUint(seedStr, 10, 64)
+		if err != nil {
+			apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid seed: %w", err)))
+			ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+			return
+		}
+		seed = &value
+	}
+
+	set, err := c.service.Roll(seed)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, set)
+}
+
+// ApplyRacialBonuses godoc
+// @Summary      Apply racial bonuses
+// @Description  Combine base ability scores with the fixed and chosen ability score bonuses of a race and, optionally, one of its subraces
+// @Tags         Ability Scores
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.RacialAbilityScoresRequest  true  "Base scores and race"
+// @Success      200      {object}  models.RacialAbilityScores
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Router       /ability-scores/racial [post]
+func (c *abilityScoreControllerGin) ApplyRacialBonuses(ctx *gin.Context) {
+	var request models.RacialAbilityScoresRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	scores, err := c.service.ApplyRacialBonuses(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, scores)
+}
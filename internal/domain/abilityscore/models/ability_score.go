@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/google/uuid"
+)
+
+const (
+	MethodPointBuy      = "point_buy"
+	MethodStandardArray = "standard_array"
+	MethodRoll          = "roll"
+)
+
+const (
+	PointBuyBudget   = 27
+	PointBuyMinScore = 8
+	PointBuyMaxScore = 15
+)
+
+// StandardArray is the fixed set of scores assigned one per ability by the standard array method.
+var StandardArray = []int{15, 14, 13, 12, 10, 8}
+
+// pointBuyCosts is the total cost of buying a score up from 8.
+var pointBuyCosts = map[int]int{8: 0, 9: 1, 10: 2, 11: 3, 12: 4, 13: 5, 14: 7, 15: 9}
+
+// AbilityRoll is one 4d6-drop-lowest roll.
+type AbilityRoll struct {
+	Ability string `json:"ability"`
+	Dice    []int  `json:"dice"`
+	Dropped int    `json:"dropped"`
+	Total   int    `json:"total"`
+}
+
+// AbilityScoreSet is a generated set of base ability scores.
+type AbilityScoreSet struct {
+	Method      string               `json:"method" enums:"point_buy,standard_array,roll"`
+	Scores      models.AbilityScores `json:"scores"`
+	Modifiers   models.AbilityScores `json:"modifiers"`
+	PointsSpent int                  `json:"points_spent,omitempty"`
+	Rolls       []AbilityRoll        `json:"rolls,omitempty"`
+	Seed        uint64               `json:"seed,omitempty"`
+}
+
+// AbilityScoresRequest carries base ability scores to validate against a generation method.
+type AbilityScoresRequest struct {
+	Scores models.AbilityScores `json:"scores" binding:"required"`
+}
+
+// RacialAbilityScoresRequest asks to combine base scores with the bonuses of a race and, optionally, one of its
+// subraces. Picks answer the ability bonus choices they offer.
+type RacialAbilityScoresRequest struct {
+	Scores    models.AbilityScores          `json:"scores" binding:"required"`
+	RaceID    uuid.UUID                     `json:"race_id" binding:"required" swaggertype:"string" format:"uuid"`
+	SubraceID *uuid.UUID                    `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	Picks     []raceModels.AbilityBonusPick `json:"picks,omitempty" swaggertype:"array,object"`
+}
+
+// RacialAbilityScores are base scores combined with racial bonuses.
+type RacialAbilityScores struct {
+	Base      models.AbilityScores `json:"base"`
+	Bonuses   models.AbilityScores `json:"bonuses"`
+	Scores    models.AbilityScores `json:"scores"`
+	Modifiers models.AbilityScores `json:"modifiers"`
+}
+
+// PointBuyCost returns the points spent on scores, failing when a score is outside the point-buy range or the
+// total exceeds the budget.
+func PointBuyCost(scores models.AbilityScores) (int, error) {
+	total := 0
+	for _, ability := range raceModels.Abilities {
+		score := scores.Get(ability)
+		cost, ok := pointBuyCosts[score]
+		if !ok {
+			return 0, fmt.Errorf("%s score must be between %d and %d for point buy: %d", ability, PointBuyMinScore, PointBuyMaxScore, score)
+		}
+		total += cost
+	}
+	if total > PointBuyBudget {
+		return total, fmt.Errorf("point buy spends %d points, over the budget of %d", total, PointBuyBudget)
+	}
+	return total, nil
+}
+
+// ValidateStandardArray checks that scores use every standard array value exactly once.
+func ValidateStandardArray(scores models.AbilityScores) error {
+	remaining := slices.Clone(StandardArray)
+	for _, ability := range raceModels.Abilities {
+		score := scores.Get(ability)
+		i := slices.Index(remaining, score)
+		if i < 0 {
+			return fmt.Errorf("%s score %d is not an unused standard array value", ability, score)
+		}
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+	return nil
+}
+
+// abilityRoll is the expression rolled for each ability: four six-sided dice, dropping the lowest.
+var abilityRoll = dice.MustParse("4d6dl1")
+
+// RollAbilityScores rolls 4d6 and drops the lowest die for each ability, in canonical ability order.
+func RollAbilityScores(roller *dice.Roller) ([]AbilityRoll, models.AbilityScores) {
+	var scores models.AbilityScores
+	rolls := make([]AbilityRoll, 0, len(raceModels.Abilities))
+	for _, ability := range raceModels.Abilities {
+		result := roller.Roll(abilityRoll)
+		roll := AbilityRoll{Ability: ability, Total: result.Total}
+		for _, die := range result.Terms[0].Dice {
+			roll.Dice = append(roll.Dice, die.Value)
+			if !die.Kept {
+				roll.Dropped = die.Value
+			}
+		}
+
+		scores.ModifyScore(ability, roll.Total)
+		rolls = append(rolls, roll)
+	}
+	return rolls, scores
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/models"
+	characterModels "github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+)
+
+type AbilityScoreService interface {
+	PointBuy(scores characterModels.AbilityScores) (*models.AbilityScoreSet, error)
+	StandardArray(scores characterModels.AbilityScores) (*models.AbilityScoreSet, error)
+	Roll(seed *uint64) (*models.AbilityScoreSet, error)
+	ApplyRacialBonuses(request *models.RacialAbilityScoresRequest) (*models.RacialAbilityScores, error)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/models"
+	characterModels "github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+)
+
+const (
+	minAbilityScore = 1
+	maxAbilityScore = 30
+)
+
+// abilityScoreServiceImpl is the concrete implementation of AbilityScoreService.
+type abilityScoreServiceImpl struct {
+	raceService raceServices.RaceService
+}
+
+// NewAbilityScoreService creates a new instance of abilityScoreServiceImpl.
+func NewAbilityScoreService(raceService raceServices.RaceService) AbilityScoreService {
+	return &abilityScoreServiceImpl{
+		raceService: raceService,
+	}
+}
+
+func (s *abilityScoreServiceImpl) PointBuy(scores characterModels.AbilityScores) (*models.AbilityScoreSet, error) {
+	spent, err := models.PointBuyCost(scores)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	return &models.AbilityScoreSet{
+		Method:      models.MethodPointBuy,
+		Scores:      scores,
+		Modifiers:   scores.Modifiers(),
+		PointsSpent: spent,
+	}, nil
+}
+
+func (s *abilityScoreServiceImpl) StandardArray(scores characterModels.AbilityScores) (*models.AbilityScoreSet, error) {
+	if err := models.ValidateStandardArray(scores); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	return &models.AbilityScoreSet{
+		Method:    models.MethodStandardArray,
+		Scores:    scores,
+		Modifiers: scores.Modifiers(),
+	}, nil
+}
+
+func (s *abilityScoreServiceImpl) Roll(seed *uint64) (*models.AbilityScoreSet, error) {
+	value := rand.Uint64()
+	if seed != nil {
+		value = *seed
+	}
+
+	rolls, scores := models.RollAbilityScores(dice.NewSeededRoller(value))
+	return &models.AbilityScoreSet{
+		Method:    models.MethodRoll,
+		Scores:    scores,
+		Modifiers: scores.Modifiers(),
+		Rolls:     rolls,
+		Seed:      value,
+	}, nil
+}
+
+func (s *abilityScoreServiceImpl) ApplyRacialBonuses(request *models.RacialAbilityScoresRequest) (*models.RacialAbilityScores, error) {
+	for _, ability := range raceModels.Abilities {
+		if score := request.Scores.Get(ability); score < minAbilityScore || score > maxAbilityScore {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s score must be between %d and %d: %d", ability, minAbilityScore, maxAbilityScore, score))
+		}
+	}
+
+	bonuses, err := s.racialBonuses(request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.RacialAbilityScores{
+		Base:    request.Scores,
+		Bonuses: bonuses,
+		Scores:  request.Scores,
+	}
+	for _, ability := range raceModels.Abilities {
+		result.Scores.ModifyScore(ability, bonuses.Get(ability))
+	}
+	result.Modifiers = result.Scores.Modifiers()
+	return result, nil
+}
+
+// racialBonuses collects the fixed and chosen ability score bonuses of the requested race, merged with its subrace
+// when one is given.
+func (s *abilityScoreServiceImpl) racialBonuses(request *models.RacialAbilityScoresRequest) (characterModels.AbilityScores, error) {
+	var bonuses characterModels.AbilityScores
+
+	resolved, err := s.raceService.ResolveAbilityBonuses(request.RaceID, &raceModels.AbilityBonusSelection{
+		SubraceID: request.SubraceID,
+		Picks:     request.Picks,
+	})
+	if err != nil {
+		return bonuses, raceLookupError(err)
+	}
+	for _, bonus := range resolved.AbilityScoreBonuses {
+		bonuses.ModifyScore(bonus.Ability, bonus.Total)
+	}
+	return bonuses, nil
+}
+
+// raceLookupError reports a race or subrace that does not exist as a bad request, since it comes from the request body.
+func raceLookupError(err error) error {
+	if errors.Is(err, failure.ErrorNotFound) {
+		return failure.NewError(failure.ErrorBadRequest, err)
+	}
+	return err
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type BackgroundController interface {
+	GetAllBackgrounds(ctx *gin.Context)
+	GetBackgroundByID(ctx *gin.Context)
+	CreateBackground(ctx *gin.Context)
+	UpdateBackground(ctx *gin.Context)
+	DeleteBackground(ctx *gin.Context)
+	RollPersonality(ctx *gin.Context)
+}
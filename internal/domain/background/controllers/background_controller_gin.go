@@ -0,0 +1,211 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// backgroundControllerGin is a concrete implementation of BackgroundController using the Gin framework.
+type backgroundControllerGin struct {
+	service services.BackgroundService
+}
+
+// NewBackgroundControllerGin creates a new instance of backgroundControllerGin.
+func NewBackgroundControllerGin(service services.BackgroundService) BackgroundController {
+	return &backgroundControllerGin{
+		service: service,
+	}
+}
+
+// GetAllBackgrounds godoc
+// @Summary      List all backgrounds
+// @Description  Return all registered backgrounds
+// @Tags         Backgrounds
+// @Accept       json
+// @Produce      json
+// @Success      200 {array}  models.Background
+// @Failure      500 {object} httperror.ErrorResponse
+// @Router       /backgrounds [get]
+func (c *backgroundControllerGin) GetAllBackgrounds(ctx *gin.Context) {
+	backgrounds, err := c.service.ListBackgrounds()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, backgrounds)
+}
+
+// GetBackgroundByID godoc
+// @Summary      Get background by ID
+// @Description  Retrieve a background using the provided ID
+// @Tags         Backgrounds
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Background ID (UUID)"
+// @Success      200  {object}  models.Background
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /backgrounds/{id} [get]
+func (c *backgroundControllerGin) GetBackgroundByID(ctx *gin.Context) {
+	id, err := parseBackgroundID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	background, err := c.service.GetBackgroundDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, background)
+}
+
+// CreateBackground godoc
+// @Summary      Create background
+// @Description  Create a new background with its proficiencies, languages, equipment and personality tables. Skill and tool proficiencies link catalog proficiencies of that category by ID or name; unknown ones are rejected.
+// @Tags         Backgrounds
+// @Accept       json
+// @Produce      json
+// @Param        background  body      models.Background  true  "Background info"
+// @Success      201         {object}  models.Background
+// @Failure      400         {object}  httperror.ErrorResponse
+// @Failure      409         {object}  httperror.ErrorResponse
+// @Failure      500         {object}  httperror.ErrorResponse
+// @Router       /backgrounds [post]
+func (c *backgroundControllerGin) CreateBackground(ctx *gin.Context) {
+	var background models.Background
+	if err := ctx.ShouldBindJSON(&background); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterBackground(&background); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, background)
+}
+
+// UpdateBackground godoc
+// @Summary      Update background
+// @Description  Update an existing background, replacing its proficiencies and languages. Skill and tool proficiencies link catalog proficiencies of that category by ID or name; unknown ones are rejected.
+// @Tags         Backgrounds
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string             true  "Background ID (UUID)"
+// @Param        background  body      models.Background  true  "Background info"
+// @Success      200         {object}  models.Background
+// @Failure      400         {object}  httperror.ErrorResponse
+// @Failure      404         {object}  httperror.ErrorResponse
+// @Failure      409         {object}  httperror.ErrorResponse
+// @Router       /backgrounds/{id} [put]
+func (c *backgroundControllerGin) UpdateBackground(ctx *gin.Context) {
+	id, err := parseBackgroundID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var background models.Background
+	if err := ctx.ShouldBindJSON(&background); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateBackgroundInfo(id, &background); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, background)
+}
+
+// DeleteBackground godoc
+// @Summary      Delete background
+// @Description  Delete an existing background
+// @Tags         Backgrounds
+// @Param        id   path      string  true  "Background ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /backgrounds/{id} [delete]
+func (c *backgroundControllerGin) DeleteBackground(ctx *gin.Context) {
+	id, err := parseBackgroundID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveBackground(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// RollPersonality godoc
+// @Summary      Roll personality
+// @Description  Draw two personality traits and one ideal, bond and flaw from the background's tables
+// @Tags         Backgrounds
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string   true  "Background ID (UUID)"
+// @Param        seed  query     integer  false  "Seed for a reproducible roll"
+// @Success      200   {object}  models.BackgroundPersonality
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Router       /backgrounds/{id}/personality [post]
+func (c *backgroundControllerGin) RollPersonality(ctx *gin.Context) {
+	id, err := parseBackgroundID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var seed *uint64
+	if seedStr, ok := ctx.GetQuery("seed"); ok {
+		value, err := strconv.ParseUint(seedStr, 10, 64)
+		if err != nil {
+			apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid seed: %w", err)))
+			ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+			return
+		}
+		seed = &value
+	}
+
+	personality, err := c.service.RollPersonality(id, seed)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, personality)
+}
+
+// parseBackgroundID reads the background ID path parameter.
+func parseBackgroundID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background ID: %w", err))
+	}
+	return id, nil
+}
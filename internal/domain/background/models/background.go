@@ -0,0 +1,64 @@
+package models
+
+import (
+	"math/rand/v2"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+// PersonalityTraitsRolled is how many distinct personality traits a character rolls from a background.
+const PersonalityTraitsRolled = 2
+
+type Background struct {
+	ID                 uuid.UUID            `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name               string               `json:"name" gorm:"unique;not null" example:"Acolyte"`
+	Description        string               `json:"description"`
+	SkillProficiencies []models.Proficiency `json:"skill_proficiencies,omitempty" gorm:"many2many:background_skill_proficiencies;constraint:OnDelete:CASCADE;"`
+	ToolProficiencies  []models.Proficiency `json:"tool_proficiencies,omitempty" gorm:"many2many:background_tool_proficiencies;constraint:OnDelete:CASCADE;"`
+	Languages          []models.Language    `json:"languages,omitempty" gorm:"many2many:background_languages;constraint:OnDelete:CASCADE;"`
+	LanguageChoices    int                  `json:"language_choices" example:"2"`
+	StartingEquipment  []string             `json:"starting_equipment" gorm:"type:jsonb;serializer:json"`
+	FeatureName        string               `json:"feature_name,omitempty" example:"Shelter of the Faithful"`
+	FeatureDescription string               `json:"feature_description,omitempty"`
+	PersonalityTraits  []string             `json:"personality_traits" gorm:"type:jsonb;serializer:json"`
+	Ideals             []string             `json:"ideals" gorm:"type:jsonb;serializer:json"`
+	Bonds              []string             `json:"bonds" gorm:"type:jsonb;serializer:json"`
+	Flaws              []string             `json:"flaws" gorm:"type:jsonb;serializer:json"`
+}
+
+// BackgroundPersonality is a random draw from a background's personality tables.
+type BackgroundPersonality struct {
+	BackgroundID      uuid.UUID `json:"background_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PersonalityTraits []string  `json:"personality_traits"`
+	Ideal             string    `json:"ideal"`
+	Bond              string    `json:"bond"`
+	Flaw              string    `json:"flaw"`
+}
+
+// RollPersonality draws distinct personality traits and one ideal, bond and flaw from the background's tables.
+func (b *Background) RollPersonality(rng *rand.Rand) BackgroundPersonality {
+	personality := BackgroundPersonality{
+		BackgroundID:      b.ID,
+		PersonalityTraits: []string{},
+		Ideal:             pick(rng, b.Ideals),
+		Bond:              pick(rng, b.Bonds),
+		Flaw:              pick(rng, b.Flaws),
+	}
+
+	for _, i := range rng.Perm(len(b.PersonalityTraits)) {
+		if len(personality.PersonalityTraits) == PersonalityTraitsRolled {
+			break
+		}
+		personality.PersonalityTraits = append(personality.PersonalityTraits, b.PersonalityTraits[i])
+	}
+	return personality
+}
+
+// pick returns a random entry of table, or an empty string when the table is empty.
+func pick(rng *rand.Rand, table []string) string {
+	if len(table) == 0 {
+		return ""
+	}
+	return table[rng.IntN(len(table))]
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	"github.com/google/uuid"
+)
+
+type BackgroundRepository interface {
+	GetAllBackgrounds() ([]*models.Background, error)
+	GetBackgroundByID(id uuid.UUID) (*models.Background, error)
+	GetBackgroundByName(name string) (*models.Background, error)
+	CreateBackground(background *models.Background) error
+	UpdateBackground(id uuid.UUID, background *models.Background) error
+	DeleteBackground(id uuid.UUID) error
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// backgroundRepositoryGormImpl is a concrete implementation of the BackgroundRepository interface using GORM.
+type backgroundRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormBackgroundRepository creates a new instance of backgroundRepositoryGormImpl.
+func NewGormBackgroundRepository(db *gorm.DB) BackgroundRepository {
+	return &backgroundRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllBackgrounds retrieves all backgrounds from the database, including their related entities.
+func (r *backgroundRepositoryGormImpl) GetAllBackgrounds() ([]*models.Background, error) {
+	var backgrounds []*models.Background
+	if err := r.db.Preload("SkillProficiencies").
+		Preload("ToolProficiencies").
+		Preload("Languages").
+		Order("name").
+		Find(&backgrounds).Error; err != nil {
+		return nil, err
+	}
+	return backgrounds, nil
+}
+
+// GetBackgroundByID retrieves a background by its ID, including its related entities.
+func (r *backgroundRepositoryGormImpl) GetBackgroundByID(id uuid.UUID) (*models.Background, error) {
+	var background models.Background
+	if err := r.db.Preload("SkillProficiencies").
+		Preload("ToolProficiencies").
+		Preload("Languages").
+		First(&background, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("background with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &background, nil
+}
+
+// GetBackgroundByName retrieves a background by its name.
+func (r *backgroundRepositoryGormImpl) GetBackgroundByName(name string) (*models.Background, error) {
+	var background models.Background
+	if err := r.db.Where("name = ?", name).First(&background).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("background with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &background, nil
+}
+
+// CreateBackground adds a new background to the database along with its languages, linking its existing proficiencies.
+func (r *backgroundRepositoryGormImpl) CreateBackground(background *models.Background) error {
+	return r.db.Omit("SkillProficiencies.*", "ToolProficiencies.*").Create(background).Error
+}
+
+// UpdateBackground updates an existing background's details and associations in the database.
+func (r *backgroundRepositoryGormImpl) UpdateBackground(id uuid.UUID, background *models.Background) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingBackground models.Background
+	if err := tx.First(&existingBackground, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("background with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	background.ID = existingBackground.ID
+
+	if err := tx.Model(&existingBackground).Omit("SkillProficiencies.*").Association("SkillProficiencies").Replace(background.SkillProficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingBackground).Omit("ToolProficiencies.*").Association("ToolProficiencies").Replace(background.ToolProficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingBackground).Association("Languages").Replace(background.Languages); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Omit("SkillProficiencies", "ToolProficiencies", "Languages").Save(background).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteBackground removes a background from the database.
+func (r *backgroundRepositoryGormImpl) DeleteBackground(id uuid.UUID) error {
+	result := r.db.Delete(&models.Background{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("background with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
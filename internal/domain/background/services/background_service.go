@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	"github.com/google/uuid"
+)
+
+type BackgroundService interface {
+	ListBackgrounds() ([]*models.Background, error)
+	GetBackgroundDetails(id uuid.UUID) (*models.Background, error)
+	RegisterBackground(background *models.Background) error
+	UpdateBackgroundInfo(id uuid.UUID, background *models.Background) error
+	RemoveBackground(id uuid.UUID) error
+	RollPersonality(id uuid.UUID, seed *uint64) (*models.BackgroundPersonality, error)
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/background/repositories"
+	proficiencyServices "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// backgroundServiceImpl is the concrete implementation of BackgroundService.
+type backgroundServiceImpl struct {
+	repo               repositories.BackgroundRepository
+	proficiencyService proficiencyServices.ProficiencyService
+}
+
+// NewBackgroundService creates a new instance of backgroundServiceImpl.
+func NewBackgroundService(repo repositories.BackgroundRepository, proficiencyService proficiencyServices.ProficiencyService) BackgroundService {
+	return &backgroundServiceImpl{
+		repo:               repo,
+		proficiencyService: proficiencyService,
+	}
+}
+
+func (s *backgroundServiceImpl) ListBackgrounds() ([]*models.Background, error) {
+	backgrounds, err := s.repo.GetAllBackgrounds()
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list backgrounds: %w", err))
+	}
+	return backgrounds, nil
+}
+
+func (s *backgroundServiceImpl) GetBackgroundDetails(id uuid.UUID) (*models.Background, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background ID: %s", id.String()))
+	}
+
+	background, err := s.repo.GetBackgroundByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get background details by ID: %w", err))
+	}
+	return background, nil
+}
+
+func (s *backgroundServiceImpl) RegisterBackground(background *models.Background) error {
+	if err := validateBackground(background); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background data: %w", err))
+	}
+	if err := s.resolveBackgroundProficiencies(background); err != nil {
+		return err
+	}
+
+	existingBackground, _ := s.repo.GetBackgroundByName(background.Name)
+	if existingBackground != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("background with name '%s' already exists", background.Name))
+	}
+
+	if err := s.repo.CreateBackground(background); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register background: %w", err))
+	}
+	return nil
+}
+
+func (s *backgroundServiceImpl) UpdateBackgroundInfo(id uuid.UUID, background *models.Background) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background ID: %s", id.String()))
+	}
+
+	if err := validateBackground(background); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background data: %w", err))
+	}
+	if err := s.resolveBackgroundProficiencies(background); err != nil {
+		return err
+	}
+
+	duplicateBackground, _ := s.repo.GetBackgroundByName(background.Name)
+	if duplicateBackground != nil && duplicateBackground.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("background with name '%s' already exists", background.Name))
+	}
+
+	if err := s.repo.UpdateBackground(id, background); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update background info: %w", err))
+	}
+	return nil
+}
+
+func (s *backgroundServiceImpl) RemoveBackground(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid background ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteBackground(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove background: %w", err))
+	}
+	return nil
+}
+
+func (s *backgroundServiceImpl) RollPersonality(id uuid.UUID, seed *uint64) (*models.BackgroundPersonality, error) {
+	background, err := s.GetBackgroundDetails(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(background.PersonalityTraits) == 0 && len(background.Ideals) == 0 && len(background.Bonds) == 0 && len(background.Flaws) == 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("background '%s' has no personality tables", background.Name))
+	}
+
+	var rng *rand.Rand
+	if seed != nil {
+		rng = rand.New(rand.NewPCG(*seed, *seed))
+	} else {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	personality := background.RollPersonality(rng)
+	return &personality, nil
+}
+
+// resolveBackgroundProficiencies links the skill and tool proficiencies of a background to their catalog entries and
+// checks the catalog lists each under the matching category.
+func (s *backgroundServiceImpl) resolveBackgroundProficiencies(background *models.Background) error {
+	if err := s.proficiencyService.ResolveProficiencies(background.SkillProficiencies); err != nil {
+		return err
+	}
+	if err := s.proficiencyService.ResolveProficiencies(background.ToolProficiencies); err != nil {
+		return err
+	}
+	if err := checkCategory(background.SkillProficiencies, raceModels.ProficiencyCategorySkill); err != nil {
+		return err
+	}
+	return checkCategory(background.ToolProficiencies, raceModels.ProficiencyCategoryTool)
+}
+
+func validateBackground(background *models.Background) error {
+	if background.Name == "" {
+		return errors.New("background name cannot be empty")
+	}
+	if background.LanguageChoices < 0 {
+		return fmt.Errorf("language choices cannot be negative: %d", background.LanguageChoices)
+	}
+
+	for _, lang := range background.Languages {
+		if lang.Name == "" {
+			return errors.New("language name cannot be empty")
+		}
+	}
+
+	tables := map[string][]string{
+		"starting equipment": background.StartingEquipment,
+		"personality trait":  background.PersonalityTraits,
+		"ideal":              background.Ideals,
+		"bond":               background.Bonds,
+		"flaw":               background.Flaws,
+	}
+	for table, entries := range tables {
+		for _, entry := range entries {
+			if entry == "" {
+				return fmt.Errorf("%s entry cannot be empty", table)
+			}
+		}
+	}
+	return nil
+}
+
+// checkCategory checks background proficiencies, already resolved against the catalog, belong to the expected category.
+func checkCategory(proficiencies []raceModels.Proficiency, category string) error {
+	for _, prof := range proficiencies {
+		if prof.Category != category {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency '%s' is a %s proficiency, expected %s", prof.Name, prof.Category, category))
+		}
+	}
+	return nil
+}
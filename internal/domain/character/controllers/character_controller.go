@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type CharacterController interface {
+	GetAllCharacters(ctx *gin.Context)
+	GetCharacterByID(ctx *gin.Context)
+	CreateCharacter(ctx *gin.Context)
+	UpdateCharacter(ctx *gin.Context)
+	DeleteCharacter(ctx *gin.Context)
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// characterControllerGin is a concrete implementation of CharacterController using the Gin framework.
+type characterControllerGin struct {
+	service services.CharacterService
+}
+
+// NewCharacterControllerGin creates a new instance of characterControllerGin.
+func NewCharacterControllerGin(service services.CharacterService) CharacterController {
+	return &characterControllerGin{
+		service: service,
+	}
+}
+
+// GetAllCharacters godoc
+// @Summary      List all characters
+// @Description  Return all registered characters
+// @Tags         Characters
+// @Accept       json
+// @Produce      json
+// @Success      200 {array}  models.Character
+// @Failure      500 {object} httperror.ErrorResponse
+// @Router       /characters [get]
+func (c *characterControllerGin) GetAllCharacters(ctx *gin.Context) {
+	characters, err := c.service.ListCharacters()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, characters)
+}
+
+// GetCharacterByID godoc
+// @Summary      Get character by ID
+// @Description  Retrieve a character using the provided ID
+// @Tags         Characters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Character ID (UUID)"
+// @Success      200  {object}  models.Character
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /characters/{id} [get]
+func (c *characterControllerGin) GetCharacterByID(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	character, err := c.service.GetCharacterDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, character)
+}
+
+// CreateCharacter godoc
+// @Summary      Create character
+// @Description  Create a new character, applying the ability score bonuses, size, speed, languages and proficiencies of its race and subrace. ability_bonus_picks must answer every ability bonus choice they offer.
+// @Tags         Characters
+// @Accept       json
+// @Produce      json
+// @Param        character  body      models.Character  true  "Character info"
+// @Success      201        {object}  models.Character
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      500        {object}  httperror.ErrorResponse
+// @Router       /characters [post]
+func (c *characterControllerGin) CreateCharacter(ctx *gin.Context) {
+	var character models.Character
+	if err := ctx.ShouldBindJSON(&character); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.CreateCharacter(&character); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, character)
+}
+
+// UpdateCharacter godoc
+// @Summary      Update character
+// @Description  Update an existing character and re-apply its race and subrace
+// @Tags         Characters
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string            true  "Character ID (UUID)"
+// @Param        character  body      models.Character  true  "Character info"
+// @Success      200        {object}  models.Character
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Router       /characters/{id} [put]
+func (c *characterControllerGin) UpdateCharacter(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var character models.Character
+	if err := ctx.ShouldBindJSON(&character); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateCharacterInfo(id, &character); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, character)
+}
+
+// DeleteCharacter godoc
+// @Summary      Delete character
+// @Description  Delete an existing character
+// @Tags         Characters
+// @Param        id   path      string  true  "Character ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /characters/{id} [delete]
+func (c *characterControllerGin) DeleteCharacter(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveCharacter(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseCharacterID reads the character ID path parameter.
+func parseCharacterID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character ID: %w", err))
+	}
+	return id, nil
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type DraftController interface {
+	StartDraft(ctx *gin.Context)
+	GetDraftByID(ctx *gin.Context)
+	DiscardDraft(ctx *gin.Context)
+	ChooseRace(ctx *gin.Context)
+	ChooseClass(ctx *gin.Context)
+	SetAbilityScores(ctx *gin.Context)
+	ChooseBackground(ctx *gin.Context)
+	ChooseEquipment(ctx *gin.Context)
+	DescribeCharacter(ctx *gin.Context)
+	ChooseSkills(ctx *gin.Context)
+	CalculateHitPoints(ctx *gin.Context)
+	ChooseSpells(ctx *gin.Context)
+	FinalizeDraft(ctx *gin.Context)
+}
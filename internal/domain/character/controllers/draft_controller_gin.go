@@ -0,0 +1,460 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// draftControllerGin is a concrete implementation of DraftController using the Gin framework.
+type draftControllerGin struct {
+	service services.DraftService
+}
+
+// NewDraftControllerGin creates a new instance of draftControllerGin.
+func NewDraftControllerGin(service services.DraftService) DraftController {
+	return &draftControllerGin{
+		service: service,
+	}
+}
+
+// StartDraft godoc
+// @Summary      Start character draft
+// @Description  Start a new guided character creation draft
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Success      201 {object} models.CharacterDraft
+// @Failure      500 {object} httperror.ErrorResponse
+// @Router       /character-drafts [post]
+func (c *draftControllerGin) StartDraft(ctx *gin.Context) {
+	draft, err := c.service.StartDraft()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, draft)
+}
+
+// GetDraftByID godoc
+// @Summary      Get character draft by ID
+// @Description  Retrieve a character draft with its completed and missing steps
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Character draft ID (UUID)"
+// @Success      200  {object}  models.CharacterDraft
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id} [get]
+func (c *draftControllerGin) GetDraftByID(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.GetDraft(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// DiscardDraft godoc
+// @Summary      Discard character draft
+// @Description  Delete a character draft. Characters already created from it are kept.
+// @Tags         Character Drafts
+// @Param        id   path      string  true  "Character draft ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id} [delete]
+func (c *draftControllerGin) DiscardDraft(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.DiscardDraft(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ChooseRace godoc
+// @Summary      Choose race
+// @Description  Choose the race and, when the race has subraces, the subrace of the character, answering every ability bonus choice they offer. Reopens the hit points step.
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftRaceStep  true  "Race"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/race [put]
+func (c *draftControllerGin) ChooseRace(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftRaceStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseRace(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ChooseClass godoc
+// @Summary      Choose class
+// @Description  Choose the class of the character. Spellcasting classes also require the spells step. Reopens the hit points and spells steps.
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                 true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftClassStep  true  "Class"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/class [put]
+func (c *draftControllerGin) ChooseClass(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftClassStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseClass(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// SetAbilityScores godoc
+// @Summary      Set ability scores
+// @Description  Set the base ability scores of the character, each between 3 and 18. Reopens the hit points step.
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id      path      string                true  "Character draft ID (UUID)"
+// @Param        scores  body      models.AbilityScores  true  "Ability scores"
+// @Success      200     {object}  models.CharacterDraft
+// @Failure      400     {object}  httperror.ErrorResponse
+// @Failure      404     {object}  httperror.ErrorResponse
+// @Failure      409     {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/ability-scores [put]
+func (c *draftControllerGin) SetAbilityScores(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var scores models.AbilityScores
+	if err := ctx.ShouldBindJSON(&scores); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.SetAbilityScores(id, &scores)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ChooseBackground godoc
+// @Summary      Choose background
+// @Description  Choose the background of the character. Reopens the skills step.
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                      true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftBackgroundStep  true  "Background"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/background [put]
+func (c *draftControllerGin) ChooseBackground(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftBackgroundStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseBackground(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ChooseEquipment godoc
+// @Summary      Choose equipment
+// @Description  Choose the starting equipment of the character
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                     true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftEquipmentStep  true  "Equipment"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/equipment [put]
+func (c *draftControllerGin) ChooseEquipment(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftEquipmentStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseEquipment(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// DescribeCharacter godoc
+// @Summary      Describe character
+// @Description  Set the name, alignment and personality of the character
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                       true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftDescriptionStep  true  "Character"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/description [put]
+func (c *draftControllerGin) DescribeCharacter(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftDescriptionStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.DescribeCharacter(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ChooseSkills godoc
+// @Summary      Choose skills
+// @Description  Choose skill proficiencies beyond the ones granted by the background
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                  true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftSkillsStep  true  "Skills"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/skills [put]
+func (c *draftControllerGin) ChooseSkills(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftSkillsStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseSkills(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// ChooseSpells godoc
+// @Summary      Choose spells
+// @Description  Choose the starting spells of a spellcasting character
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                  true  "Character draft ID (UUID)"
+// @Param        step  body      models.DraftSpellsStep  true  "Spells"
+// @Success      200   {object}  models.CharacterDraft
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      404   {object}  httperror.ErrorResponse
+// @Failure      409   {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/spells [put]
+func (c *draftControllerGin) ChooseSpells(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var step models.DraftSpellsStep
+	if err := ctx.ShouldBindJSON(&step); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.ChooseSpells(id, &step)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// CalculateHitPoints godoc
+// @Summary      Calculate hit points
+// @Description  Calculate the level 1 hit points and unarmored armor class from the class hit die and the racial ability scores
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Character draft ID (UUID)"
+// @Success      200  {object}  models.CharacterDraft
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Failure      409  {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/hit-points [post]
+func (c *draftControllerGin) CalculateHitPoints(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	draft, err := c.service.CalculateHitPoints(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, draft)
+}
+
+// FinalizeDraft godoc
+// @Summary      Finalize character draft
+// @Description  Create the character once every required step is complete. The draft is kept, marked as finalized.
+// @Tags         Character Drafts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Character draft ID (UUID)"
+// @Success      201  {object}  models.Character
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Failure      409  {object}  httperror.ErrorResponse
+// @Router       /character-drafts/{id}/finalize [post]
+func (c *draftControllerGin) FinalizeDraft(ctx *gin.Context) {
+	id, err := parseDraftID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	character, err := c.service.FinalizeDraft(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, character)
+}
+
+// parseDraftID reads the character draft ID path parameter.
+func parseDraftID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character draft ID: %w", err))
+	}
+	return id, nil
+}
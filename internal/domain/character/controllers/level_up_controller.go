@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type LevelUpController interface {
+	PreviewLevelUp(ctx *gin.Context)
+	LevelUp(ctx *gin.Context)
+	RevertLevelUp(ctx *gin.Context)
+	GetLevelHistory(ctx *gin.Context)
+	AwardExperience(ctx *gin.Context)
+}
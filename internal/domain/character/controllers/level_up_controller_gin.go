@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// levelUpControllerGin is a concrete implementation of LevelUpController using the Gin framework.
+type levelUpControllerGin struct {
+	service services.LevelUpService
+}
+
+// NewLevelUpControllerGin creates a new instance of levelUpControllerGin.
+func NewLevelUpControllerGin(service services.LevelUpService) LevelUpController {
+	return &levelUpControllerGin{
+		service: service,
+	}
+}
+
+// PreviewLevelUp godoc
+// @Summary      Preview next level
+// @Description  Show the features, hit points and ability score improvement a character gains at its next level, and whether it has the experience to advance
+// @Tags         Character Levels
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Character ID (UUID)"
+// @Success      200  {object}  models.LevelUpPreview
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /characters/{id}/level-up [get]
+func (c *levelUpControllerGin) PreviewLevelUp(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	preview, err := c.service.PreviewLevelUp(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, preview)
+}
+
+// LevelUp godoc
+// @Summary      Level up character
+// @Description  Advance a character one level in its class. Hit points are rolled or averaged from the hit die plus the Constitution modifier, and levels granting an ability score improvement require ability increases or a feat.
+// @Tags         Character Levels
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                 true  "Character ID (UUID)"
+// @Param        request  body      models.LevelUpRequest  true  "Level up choices"
+// @Success      201      {object}  models.CharacterLevelUp
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Router       /characters/{id}/level-up [post]
+func (c *levelUpControllerGin) LevelUp(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.LevelUpRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	levelUp, err := c.service.LevelUp(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, levelUp)
+}
+
+// RevertLevelUp godoc
+// @Summary      Revert last level up
+// @Description  Undo the latest level gained by a character, restoring its previous level, ability scores, hit points and feats
+// @Tags         Character Levels
+// @Produce      json
+// @Param        id   path      string  true  "Character ID (UUID)"
+// @Success      200  {object}  models.Character
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Failure      409  {object}  httperror.ErrorResponse
+// @Router       /characters/{id}/level-up [delete]
+func (c *levelUpControllerGin) RevertLevelUp(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	character, err := c.service.RevertLevelUp(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, character)
+}
+
+// GetLevelHistory godoc
+// @Summary      Get level history
+// @Description  Retrieve every level gained by a character, in level order
+// @Tags         Character Levels
+// @Produce      json
+// @Param        id   path      string  true  "Character ID (UUID)"
+// @Success      200  {array}   models.CharacterLevelUp
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /characters/{id}/level-history [get]
+func (c *levelUpControllerGin) GetLevelHistory(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	history, err := c.service.GetLevelHistory(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, history)
+}
+
+// AwardExperience godoc
+// @Summary      Award experience
+// @Description  Add experience points to a character that advances by experience
+// @Tags         Character Levels
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                  true  "Character ID (UUID)"
+// @Param        award  body      models.ExperienceAward  true  "Experience points"
+// @Success      200    {object}  models.Character
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Router       /characters/{id}/experience [post]
+func (c *levelUpControllerGin) AwardExperience(ctx *gin.Context) {
+	id, err := parseCharacterID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var award models.ExperienceAward
+	if err := ctx.ShouldBindJSON(&award); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	character, err := c.service.AwardExperience(id, &award)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, character)
+}
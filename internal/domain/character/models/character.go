@@ -0,0 +1,183 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type Character struct {
+	ID                   uuid.UUID                 `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name                 string                    `json:"name" gorm:"not null"`
+	Level                int                       `json:"level" gorm:"not null;default:1"`
+	AdvancementMode      string                    `json:"advancement_mode" gorm:"not null;default:experience" enums:"experience,milestone"`
+	ExperiencePoints     int                       `json:"experience_points" gorm:"not null;default:0"`
+	RaceID               uuid.UUID                 `json:"race_id" gorm:"type:uuid;not null;index" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubraceID            *uuid.UUID                `json:"subrace_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ClassID              *uuid.UUID                `json:"class_id,omitempty" gorm:"type:uuid;index" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	BackgroundID         *uuid.UUID                `json:"background_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Alignment            string                    `json:"alignment,omitempty"`
+	BaseAbilityScores    AbilityScores             `json:"base_ability_scores" gorm:"embedded;embeddedPrefix:base_"`
+	AbilityBonusPicks    []models.AbilityBonusPick `json:"ability_bonus_picks,omitempty" gorm:"type:jsonb;serializer:json"`
+	AbilityScores        AbilityScores             `json:"ability_scores" gorm:"embedded"`
+	Size                 string                    `json:"size"`
+	Speed                int8                      `json:"speed"`
+	Languages            []models.Language         `json:"languages,omitempty" gorm:"many2many:character_languages;constraint:OnDelete:CASCADE;"`
+	Proficiencies        []models.Proficiency      `json:"proficiencies,omitempty" gorm:"many2many:character_proficiencies;constraint:OnDelete:CASCADE;"`
+	RacialLanguageIDs    []uuid.UUID               `json:"-" gorm:"type:jsonb;serializer:json"`
+	RacialProficiencyIDs []uuid.UUID               `json:"-" gorm:"type:jsonb;serializer:json"`
+	MaxHitPoints         int                       `json:"max_hit_points"`
+	ArmorClass           int                       `json:"armor_class"`
+	Equipment            []string                  `json:"equipment,omitempty" gorm:"type:jsonb;serializer:json"`
+	Spells               []string                  `json:"spells,omitempty" gorm:"type:jsonb;serializer:json"`
+	Feats                []string                  `json:"feats,omitempty" gorm:"type:jsonb;serializer:json"`
+	PersonalityTraits    []string                  `json:"personality_traits,omitempty" gorm:"type:jsonb;serializer:json"`
+	Ideal                string                    `json:"ideal,omitempty"`
+	Bond                 string                    `json:"bond,omitempty"`
+	Flaw                 string                    `json:"flaw,omitempty"`
+	LevelHistory         []CharacterLevelUp        `json:"-" gorm:"foreignKey:CharacterID;constraint:OnDelete:CASCADE;"`
+}
+
+type AbilityScores struct {
+	Strength     int `json:"strength"`
+	Dexterity    int `json:"dexterity"`
+	Constitution int `json:"constitution"`
+	Intelligence int `json:"intelligence"`
+	Wisdom       int `json:"wisdom"`
+	Charisma     int `json:"charisma"`
+}
+
+// Get returns the score of the named ability, or zero for an unknown ability.
+func (a AbilityScores) Get(ability string) int {
+	switch ability {
+	case models.AbilityStrength:
+		return a.Strength
+	case models.AbilityDexterity:
+		return a.Dexterity
+	case models.AbilityConstitution:
+		return a.Constitution
+	case models.AbilityIntelligence:
+		return a.Intelligence
+	case models.AbilityWisdom:
+		return a.Wisdom
+	case models.AbilityCharisma:
+		return a.Charisma
+	}
+	return 0
+}
+
+// ModifyScore adds value to the named ability score. Unknown abilities are ignored.
+func (a *AbilityScores) ModifyScore(ability string, value int) {
+	switch ability {
+	case models.AbilityStrength:
+		a.Strength += value
+	case models.AbilityDexterity:
+		a.Dexterity += value
+	case models.AbilityConstitution:
+		a.Constitution += value
+	case models.AbilityIntelligence:
+		a.Intelligence += value
+	case models.AbilityWisdom:
+		a.Wisdom += value
+	case models.AbilityCharisma:
+		a.Charisma += value
+	}
+}
+
+// Modifiers returns the ability modifier of every score.
+func (a AbilityScores) Modifiers() AbilityScores {
+	return AbilityScores{
+		Strength:     Modifier(a.Strength),
+		Dexterity:    Modifier(a.Dexterity),
+		Constitution: Modifier(a.Constitution),
+		Intelligence: Modifier(a.Intelligence),
+		Wisdom:       Modifier(a.Wisdom),
+		Charisma:     Modifier(a.Charisma),
+	}
+}
+
+// ResetRacialFeatures clears everything a race grants so it can be applied again from the base scores. Only the
+// languages and proficiencies the race added are removed; those granted by the background or chosen are kept.
+func (c *Character) ResetRacialFeatures() {
+	c.AbilityScores = c.BaseAbilityScores
+	c.Size = ""
+	c.Speed = 0
+	c.Languages = slices.DeleteFunc(c.Languages, func(language models.Language) bool {
+		return slices.Contains(c.RacialLanguageIDs, language.ID)
+	})
+	c.Proficiencies = slices.DeleteFunc(c.Proficiencies, func(proficiency models.Proficiency) bool {
+		return slices.Contains(c.RacialProficiencyIDs, proficiency.ID)
+	})
+	c.RacialLanguageIDs = nil
+	c.RacialProficiencyIDs = nil
+}
+
+// RacialGrants returns the target a race is applied through. It records the languages and proficiencies the race
+// adds so ResetRacialFeatures can remove them again.
+func (c *Character) RacialGrants() models.RaceTarget {
+	return &racialGrants{Character: c}
+}
+
+// racialGrants applies a race to a character, recording the languages and proficiencies the character did not
+// know yet.
+type racialGrants struct {
+	*Character
+}
+
+func (g *racialGrants) AddLanguage(language models.Language) {
+	if !slices.ContainsFunc(g.Languages, func(known models.Language) bool { return known.ID == language.ID }) {
+		g.RacialLanguageIDs = append(g.RacialLanguageIDs, language.ID)
+	}
+	g.Character.AddLanguage(language)
+}
+
+func (g *racialGrants) AddProficiency(proficiency models.Proficiency) {
+	if !slices.ContainsFunc(g.Proficiencies, func(known models.Proficiency) bool { return known.ID == proficiency.ID }) {
+		g.RacialProficiencyIDs = append(g.RacialProficiencyIDs, proficiency.ID)
+	}
+	g.Character.AddProficiency(proficiency)
+}
+
+// Modifier returns the ability modifier of a score.
+func Modifier(score int) int {
+	if score >= 10 {
+		return (score - 10) / 2
+	}
+	return (score - 11) / 2
+}
+
+// ApplyAbilityBonus adds a racial bonus to the named ability score.
+func (c *Character) ApplyAbilityBonus(ability string, bonus int) {
+	c.AbilityScores.ModifyScore(ability, bonus)
+}
+
+// SetSize sets the character's size category.
+func (c *Character) SetSize(size string) {
+	c.Size = size
+}
+
+// SetSpeed sets the character's walking speed.
+func (c *Character) SetSpeed(speed int8) {
+	c.Speed = speed
+}
+
+// AddLanguage teaches the character a language it does not know yet.
+func (c *Character) AddLanguage(language models.Language) {
+	for _, known := range c.Languages {
+		if known.ID == language.ID {
+			return
+		}
+	}
+	c.Languages = append(c.Languages, language)
+}
+
+// AddProficiency grants the character a proficiency it does not have yet.
+func (c *Character) AddProficiency(proficiency models.Proficiency) {
+	for _, known := range c.Proficiencies {
+		if known.ID == proficiency.ID {
+			return
+		}
+	}
+	c.Proficiencies = append(c.Proficiencies, proficiency)
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+const (
+	DraftStatusInProgress = "in_progress"
+	DraftStatusFinalized  = "finalized"
+)
+
+// Creation steps, in the order the character creation flow visits them.
+const (
+	StepRace          = "race"
+	StepClass         = "class"
+	StepAbilityScores = "ability_scores"
+	StepBackground    = "background"
+	StepEquipment     = "equipment"
+	StepDescription   = "description"
+	StepSkills        = "skills"
+	StepHitPoints     = "hit_points"
+	StepSpells        = "spells"
+)
+
+// DraftSteps lists the creation steps in order. Review is not a step: it is the finalization itself.
+var DraftSteps = []string{
+	StepRace,
+	StepClass,
+	StepAbilityScores,
+	StepBackground,
+	StepEquipment,
+	StepDescription,
+	StepSkills,
+	StepHitPoints,
+	StepSpells,
+}
+
+// stepDependents lists, for each step, the later steps whose result depends on it and must be redone when it changes.
+var stepDependents = map[string][]string{
+	StepRace:          {StepHitPoints},
+	StepClass:         {StepHitPoints, StepSpells},
+	StepAbilityScores: {StepHitPoints},
+	StepBackground:    {StepSkills},
+}
+
+// CharacterDraft holds the choices made so far while creating a character step by step.
+type CharacterDraft struct {
+	ID                  uuid.UUID                 `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status              string                    `json:"status" gorm:"not null;default:in_progress" enums:"in_progress,finalized"`
+	CompletedSteps      []string                  `json:"completed_steps" gorm:"type:jsonb;serializer:json"`
+	RaceID              *uuid.UUID                `json:"race_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid"`
+	SubraceID           *uuid.UUID                `json:"subrace_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid"`
+	ClassID             *uuid.UUID                `json:"class_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid"`
+	RequiresSpells      bool                      `json:"requires_spells"`
+	AbilityBonusPicks   []models.AbilityBonusPick `json:"ability_bonus_picks,omitempty" gorm:"type:jsonb;serializer:json"`
+	AbilityScores       AbilityScores             `json:"ability_scores" gorm:"embedded;embeddedPrefix:base_"`
+	BackgroundID        *uuid.UUID                `json:"background_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid"`
+	Equipment           []string                  `json:"equipment,omitempty" gorm:"type:jsonb;serializer:json"`
+	Name                string                    `json:"name,omitempty"`
+	Alignment           string                    `json:"alignment,omitempty"`
+	PersonalityTraits   []string                  `json:"personality_traits,omitempty" gorm:"type:jsonb;serializer:json"`
+	Ideal               string                    `json:"ideal,omitempty"`
+	Bond                string                    `json:"bond,omitempty"`
+	Flaw                string                    `json:"flaw,omitempty"`
+	SkillProficiencyIDs []uuid.UUID               `json:"skill_proficiency_ids,omitempty" gorm:"type:jsonb;serializer:json" swaggertype:"array,string"`
+	MaxHitPoints        int                       `json:"max_hit_points,omitempty"`
+	ArmorClass          int                       `json:"armor_class,omitempty"`
+	Spells              []string                  `json:"spells,omitempty" gorm:"type:jsonb;serializer:json"`
+	CharacterID         *uuid.UUID                `json:"character_id,omitempty" gorm:"type:uuid" swaggertype:"string" format:"uuid"`
+	Missing             []string                  `json:"missing_steps" gorm:"-"`
+	CreatedAt           time.Time                 `json:"created_at"`
+	UpdatedAt           time.Time                 `json:"updated_at"`
+}
+
+// DraftRaceStep is the payload of the race step. AbilityBonusPicks answers every ability bonus choice of the race
+// and the subrace.
+type DraftRaceStep struct {
+	RaceID            uuid.UUID                 `json:"race_id" binding:"required" swaggertype:"string" format:"uuid"`
+	SubraceID         *uuid.UUID                `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	AbilityBonusPicks []models.AbilityBonusPick `json:"ability_bonus_picks,omitempty"`
+}
+
+// DraftClassStep is the payload of the class step.
+type DraftClassStep struct {
+	ClassID uuid.UUID `json:"class_id" binding:"required" swaggertype:"string" format:"uuid"`
+}
+
+// DraftBackgroundStep is the payload of the background step.
+type DraftBackgroundStep struct {
+	BackgroundID uuid.UUID `json:"background_id" binding:"required" swaggertype:"string" format:"uuid"`
+}
+
+// DraftEquipmentStep is the payload of the equipment step.
+type DraftEquipmentStep struct {
+	Equipment []string `json:"equipment" binding:"required"`
+}
+
+// DraftDescriptionStep is the payload of the description step.
+type DraftDescriptionStep struct {
+	Name              string   `json:"name" binding:"required"`
+	Alignment         string   `json:"alignment"`
+	PersonalityTraits []string `json:"personality_traits"`
+	Ideal             string   `json:"ideal"`
+	Bond              string   `json:"bond"`
+	Flaw              string   `json:"flaw"`
+}
+
+// DraftSkillsStep is the payload of the skills step.
+type DraftSkillsStep struct {
+	ProficiencyIDs []uuid.UUID `json:"proficiency_ids" binding:"required" swaggertype:"array,string"`
+}
+
+// DraftSpellsStep is the payload of the spells step.
+type DraftSpellsStep struct {
+	Spells []string `json:"spells" binding:"required"`
+}
+
+// IsComplete reports whether the step has been completed.
+func (d *CharacterDraft) IsComplete(step string) bool {
+	return slices.Contains(d.CompletedSteps, step)
+}
+
+// Complete marks the step as done and reopens every later step that depends on it.
+func (d *CharacterDraft) Complete(step string) {
+	d.CompletedSteps = slices.DeleteFunc(d.CompletedSteps, func(s string) bool {
+		return s == step || slices.Contains(stepDependents[step], s)
+	})
+	d.CompletedSteps = append(d.CompletedSteps, step)
+}
+
+// IsRequired reports whether the step must be completed before finalization.
+func (d *CharacterDraft) IsRequired(step string) bool {
+	return step != StepSpells || d.RequiresSpells
+}
+
+// MissingBefore returns the required steps preceding step that are not completed yet.
+func (d *CharacterDraft) MissingBefore(step string) []string {
+	missing := []string{}
+	for _, s := range DraftSteps {
+		if s == step {
+			break
+		}
+		if d.IsRequired(s) && !d.IsComplete(s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+// MissingSteps returns every required step that is not completed yet and records them in Missing.
+func (d *CharacterDraft) MissingSteps() []string {
+	d.Missing = []string{}
+	for _, s := range DraftSteps {
+		if d.IsRequired(s) && !d.IsComplete(s) {
+			d.Missing = append(d.Missing, s)
+		}
+	}
+	return d.Missing
+}
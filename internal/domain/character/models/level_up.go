@@ -0,0 +1,111 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Advancement modes: characters either level up by reaching experience thresholds or when the DM awards a milestone.
+const (
+	AdvancementExperience = "experience"
+	AdvancementMilestone  = "milestone"
+)
+
+const (
+	HitPointsAverage = "average"
+	HitPointsRoll    = "roll"
+)
+
+// MaxImprovedAbilityScore is the highest score an ability score improvement can raise an ability to.
+const MaxImprovedAbilityScore = 20
+
+// AbilityScoreImprovementPoints is the number of points an ability score improvement distributes.
+const AbilityScoreImprovementPoints = 2
+
+// ExperienceThresholds holds, at index level-1, the experience points needed to reach each level.
+var ExperienceThresholds = []int{
+	0, 300, 900, 2700, 6500, 14000, 23000, 34000, 48000, 64000,
+	85000, 100000, 120000, 140000, 165000, 195000, 225000, 265000, 305000, 355000,
+}
+
+// AbilityIncrease raises one ability score as part of an ability score improvement.
+type AbilityIncrease struct {
+	Ability string `json:"ability" binding:"required" enums:"strength,dexterity,constitution,intelligence,wisdom,charisma" example:"strength"`
+	Amount  int    `json:"amount" binding:"required" example:"1"`
+}
+
+// LevelFeature is a class feature gained when reaching a level.
+type LevelFeature struct {
+	Name        string `json:"name" example:"Extra Attack"`
+	Description string `json:"description,omitempty"`
+}
+
+// LevelUpRequest holds the choices made when a character gains a level. A level granting an ability score
+// improvement requires either AbilityIncreases or a Feat, and other levels accept neither. A Seed must fit in a signed
+// 64-bit integer.
+type LevelUpRequest struct {
+	HitPoints        string            `json:"hit_points" enums:"average,roll" example:"average"`
+	Seed             *uint64           `json:"seed,omitempty"`
+	AbilityIncreases []AbilityIncrease `json:"ability_increases,omitempty"`
+	Feat             string            `json:"feat,omitempty" example:"Alert"`
+}
+
+// ExperienceAward adds experience points to a character.
+type ExperienceAward struct {
+	Amount int `json:"amount" binding:"required" example:"300"`
+}
+
+// LevelUpPreview describes what a character gains at its next level and whether it may advance.
+type LevelUpPreview struct {
+	CharacterID             uuid.UUID      `json:"character_id" swaggertype:"string" format:"uuid"`
+	Level                   int            `json:"level" example:"5"`
+	AdvancementMode         string         `json:"advancement_mode" enums:"experience,milestone"`
+	ExperiencePoints        int            `json:"experience_points" example:"6500"`
+	ExperienceRequired      int            `json:"experience_required,omitempty" example:"6500"`
+	Eligible                bool           `json:"eligible"`
+	HitDie                  int            `json:"hit_die" example:"10"`
+	AverageHitPoints        int            `json:"average_hit_points" example:"8"`
+	Features                []LevelFeature `json:"features"`
+	AbilityScoreImprovement bool           `json:"ability_score_improvement"`
+}
+
+// CharacterLevelUp records one level gained by a character, with enough of the previous state to revert it.
+type CharacterLevelUp struct {
+	ID                        uuid.UUID         `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CharacterID               uuid.UUID         `json:"character_id" gorm:"type:uuid;not null;index" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ClassID                   uuid.UUID         `json:"class_id" gorm:"type:uuid;not null" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Level                     int               `json:"level" gorm:"not null" example:"5"`
+	HitPointMethod            string            `json:"hit_point_method" gorm:"not null" enums:"average,roll"`
+	HitDie                    int               `json:"hit_die" example:"10"`
+	HitDieResult              int               `json:"hit_die_result" example:"6"`
+	Seed                      *uint64           `json:"seed,omitempty"`
+	HitPointsGained           int               `json:"hit_points_gained" example:"9"`
+	AbilityIncreases          []AbilityIncrease `json:"ability_increases,omitempty" gorm:"type:jsonb;serializer:json"`
+	Feat                      string            `json:"feat,omitempty"`
+	Features                  []LevelFeature    `json:"features" gorm:"type:jsonb;serializer:json"`
+	PreviousBaseAbilityScores AbilityScores     `json:"-" gorm:"embedded;embeddedPrefix:previous_base_"`
+	PreviousAbilityScores     AbilityScores     `json:"-" gorm:"embedded;embeddedPrefix:previous_"`
+	PreviousMaxHitPoints      int               `json:"-"`
+	CreatedAt                 time.Time         `json:"created_at"`
+}
+
+// ExperienceForLevel returns the experience points needed to reach the given level.
+func ExperienceForLevel(level int) int {
+	level = min(max(level, 1), len(ExperienceThresholds))
+	return ExperienceThresholds[level-1]
+}
+
+// LevelForExperience returns the highest level the given experience points reach.
+func LevelForExperience(experience int) int {
+	level := 1
+	for level < len(ExperienceThresholds) && experience >= ExperienceThresholds[level] {
+		level++
+	}
+	return level
+}
+
+// AverageHitDie returns the fixed hit point value a character may take instead of rolling its hit die.
+func AverageHitDie(hitDie int) int {
+	return hitDie/2 + 1
+}
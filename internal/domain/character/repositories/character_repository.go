@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type CharacterRepository interface {
+	GetAllCharacters() ([]*models.Character, error)
+	GetCharacterByID(id uuid.UUID) (*models.Character, error)
+	CreateCharacter(character *models.Character) error
+	UpdateCharacter(id uuid.UUID, character *models.Character) error
+	DeleteCharacter(id uuid.UUID) error
+}
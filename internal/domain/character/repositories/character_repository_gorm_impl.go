@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// characterRepositoryGormImpl is a concrete implementation of the CharacterRepository interface using GORM.
+type characterRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormCharacterRepository creates a new instance of characterRepositoryGormImpl.
+func NewGormCharacterRepository(db *gorm.DB) CharacterRepository {
+	return &characterRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllCharacters retrieves all characters from the database, including their related entities.
+func (r *characterRepositoryGormImpl) GetAllCharacters() ([]*models.Character, error) {
+	var characters []*models.Character
+	if err := r.db.Preload("Languages").
+		Preload("Proficiencies").
+		Order("name").
+		Find(&characters).Error; err != nil {
+		return nil, err
+	}
+	return characters, nil
+}
+
+// GetCharacterByID retrieves a character by its ID, including its related entities.
+func (r *characterRepositoryGormImpl) GetCharacterByID(id uuid.UUID) (*models.Character, error) {
+	var character models.Character
+	if err := r.db.Preload("Languages").
+		Preload("Proficiencies").
+		First(&character, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("character with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &character, nil
+}
+
+// CreateCharacter adds a new character to the database along with its related entities.
+func (r *characterRepositoryGormImpl) CreateCharacter(character *models.Character) error {
+	return r.db.Create(character).Error
+}
+
+// UpdateCharacter updates an existing character's details and associations in the database.
+func (r *characterRepositoryGormImpl) UpdateCharacter(id uuid.UUID, character *models.Character) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingCharacter models.Character
+	if err := tx.First(&existingCharacter, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("character with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	character.ID = existingCharacter.ID
+
+	if err := tx.Model(&existingCharacter).Association("Languages").Replace(character.Languages); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingCharacter).Association("Proficiencies").Replace(character.Proficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Omit("Languages", "Proficiencies").Save(character).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteCharacter removes a character from the database.
+func (r *characterRepositoryGormImpl) DeleteCharacter(id uuid.UUID) error {
+	result := r.db.Delete(&models.Character{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("character with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
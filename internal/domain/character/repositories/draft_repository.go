@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type DraftRepository interface {
+	GetDraftByID(id uuid.UUID) (*models.CharacterDraft, error)
+	CreateDraft(draft *models.CharacterDraft) error
+	UpdateDraft(draft *models.CharacterDraft) error
+	DeleteDraft(id uuid.UUID) error
+	FinalizeDraft(draft *models.CharacterDraft, character *models.Character) error
+}
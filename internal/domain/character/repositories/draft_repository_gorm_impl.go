@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// draftRepositoryGormImpl is a concrete implementation of the DraftRepository interface using GORM.
+type draftRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormDraftRepository creates a new instance of draftRepositoryGormImpl.
+func NewGormDraftRepository(db *gorm.DB) DraftRepository {
+	return &draftRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetDraftByID retrieves a character draft by its ID.
+func (r *draftRepositoryGormImpl) GetDraftByID(id uuid.UUID) (*models.CharacterDraft, error) {
+	var draft models.CharacterDraft
+	if err := r.db.First(&draft, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("character draft with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// CreateDraft adds a new character draft to the database.
+func (r *draftRepositoryGormImpl) CreateDraft(draft *models.CharacterDraft) error {
+	return r.db.Create(draft).Error
+}
+
+// UpdateDraft saves every field of a character draft that is still in progress. A draft finalized since it was read
+// is reported as a conflict, so a late save never reopens it.
+func (r *draftRepositoryGormImpl) UpdateDraft(draft *models.CharacterDraft) error {
+	result := r.db.Model(draft).
+		Where("status = ?", models.DraftStatusInProgress).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(draft)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := r.db.Model(&models.CharacterDraft{}).Where("id = ?", draft.ID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("character draft with ID %s is already finalized: %w", draft.ID.String(), failure.ErrorConflict)
+		}
+		return fmt.Errorf("character draft with ID %s %w", draft.ID.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
+
+// DeleteDraft removes a character draft from the database.
+func (r *draftRepositoryGormImpl) DeleteDraft(id uuid.UUID) error {
+	result := r.db.Delete(&models.CharacterDraft{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("character draft with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
+
+// FinalizeDraft creates the character of a draft and marks the draft as finalized in a single transaction, so a
+// failure never leaves a character behind an open draft. A draft finalized concurrently is reported as a conflict.
+func (r *draftRepositoryGormImpl) FinalizeDraft(draft *models.CharacterDraft, character *models.Character) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Create(character).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	draft.Status = models.DraftStatusFinalized
+	draft.CharacterID = &character.ID
+	result := tx.Model(draft).
+		Where("status = ?", models.DraftStatusInProgress).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(draft)
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return fmt.Errorf("character draft with ID %s is already finalized: %w", draft.ID.String(), failure.ErrorConflict)
+	}
+
+	return tx.Commit().Error
+}
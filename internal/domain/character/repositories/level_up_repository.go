@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type LevelUpRepository interface {
+	GetLevelHistory(characterID uuid.UUID) ([]*models.CharacterLevelUp, error)
+	GetLatestLevelUp(characterID uuid.UUID) (*models.CharacterLevelUp, error)
+	SaveLevelUp(character *models.Character, levelUp *models.CharacterLevelUp) error
+	RevertLevelUp(character *models.Character, levelUp *models.CharacterLevelUp) error
+	AddExperience(characterID uuid.UUID, amount int) error
+}
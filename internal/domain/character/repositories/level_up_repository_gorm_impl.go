@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// levelUpRepositoryGormImpl is a concrete implementation of the LevelUpRepository interface using GORM.
+type levelUpRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormLevelUpRepository creates a new instance of levelUpRepositoryGormImpl.
+func NewGormLevelUpRepository(db *gorm.DB) LevelUpRepository {
+	return &levelUpRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetLevelHistory retrieves every level gained by a character, in level order.
+func (r *levelUpRepositoryGormImpl) GetLevelHistory(characterID uuid.UUID) ([]*models.CharacterLevelUp, error) {
+	var history []*models.CharacterLevelUp
+	if err := r.db.Where("character_id = ?", characterID).
+		Order("level").
+		Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// GetLatestLevelUp retrieves the most recent level gained by a character.
+func (r *levelUpRepositoryGormImpl) GetLatestLevelUp(characterID uuid.UUID) (*models.CharacterLevelUp, error) {
+	var levelUp models.CharacterLevelUp
+	if err := r.db.Where("character_id = ?", characterID).
+		Order("level DESC").
+		First(&levelUp).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("level up of character %s %w", characterID.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &levelUp, nil
+}
+
+// SaveLevelUp stores the advanced character and records the level gained in a single transaction.
+func (r *levelUpRepositoryGormImpl) SaveLevelUp(character *models.Character, levelUp *models.CharacterLevelUp) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := saveCharacterColumns(tx, character, character.Level-1); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Create(levelUp).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// RevertLevelUp stores the restored character and deletes the reverted level in a single transaction.
+func (r *levelUpRepositoryGormImpl) RevertLevelUp(character *models.Character, levelUp *models.CharacterLevelUp) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := saveCharacterColumns(tx, character, levelUp.Level); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(levelUp).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// AddExperience adds experience points to a character.
+func (r *levelUpRepositoryGormImpl) AddExperience(characterID uuid.UUID, amount int) error {
+	result := r.db.Model(&models.Character{}).
+		Where("id = ?", characterID).
+		Update("experience_points", gorm.Expr("experience_points + ?", amount))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("character with ID %s %w", characterID.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
+
+// saveCharacterColumns updates the character's own columns, provided it is still at the expected level. This keeps
+// two concurrent level changes from both applying to the same level.
+func saveCharacterColumns(tx *gorm.DB, character *models.Character, expectedLevel int) error {
+	result := tx.Model(character).
+		Where("level = ?", expectedLevel).
+		Select("*").
+		Omit("id", clause.Associations).
+		Updates(character)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("character with ID %s is no longer at level %d: %w", character.ID.String(), expectedLevel, failure.ErrorConflict)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type CharacterService interface {
+	ListCharacters() ([]*models.Character, error)
+	GetCharacterDetails(id uuid.UUID) (*models.Character, error)
+	CreateCharacter(character *models.Character) error
+	UpdateCharacterInfo(id uuid.UUID, character *models.Character) error
+	RemoveCharacter(id uuid.UUID) error
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/repositories"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const (
+	minLevel        = 1
+	maxLevel        = 20
+	minAbilityScore = 1
+	maxAbilityScore = 30
+)
+
+// characterServiceImpl is the concrete implementation of CharacterService.
+type characterServiceImpl struct {
+	repo        repositories.CharacterRepository
+	raceService raceServices.RaceService
+}
+
+// NewCharacterService creates a new instance of characterServiceImpl.
+func NewCharacterService(repo repositories.CharacterRepository, raceService raceServices.RaceService) CharacterService {
+	return &characterServiceImpl{
+		repo:        repo,
+		raceService: raceService,
+	}
+}
+
+func (s *characterServiceImpl) ListCharacters() ([]*models.Character, error) {
+	characters, err := s.repo.GetAllCharacters()
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list characters: %w", err))
+	}
+	return characters, nil
+}
+
+func (s *characterServiceImpl) GetCharacterDetails(id uuid.UUID) (*models.Character, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character ID: %s", id.String()))
+	}
+
+	character, err := s.repo.GetCharacterByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get character details by ID: %w", err))
+	}
+	return character, nil
+}
+
+func (s *characterServiceImpl) CreateCharacter(character *models.Character) error {
+	if err := validateCharacter(character); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character data: %w", err))
+	}
+
+	if err := applyRace(s.raceService, character); err != nil {
+		return err
+	}
+
+	if err := s.repo.CreateCharacter(character); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to create character: %w", err))
+	}
+	return nil
+}
+
+func (s *characterServiceImpl) UpdateCharacterInfo(id uuid.UUID, character *models.Character) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character ID: %s", id.String()))
+	}
+
+	if err := validateCharacter(character); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character data: %w", err))
+	}
+
+	existing, err := s.repo.GetCharacterByID(id)
+	if err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update character info: %w", err))
+	}
+	// The racial grants are not part of the request, so the stored ones tell which languages and proficiencies
+	// the previous race added.
+	character.RacialLanguageIDs = existing.RacialLanguageIDs
+	character.RacialProficiencyIDs = existing.RacialProficiencyIDs
+
+	if err := applyRace(s.raceService, character); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateCharacter(id, character); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update character info: %w", err))
+	}
+	return nil
+}
+
+func (s *characterServiceImpl) RemoveCharacter(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteCharacter(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove character: %w", err))
+	}
+	return nil
+}
+
+// applyRace recomputes the character's racial features from the stored race and subrace, including the ability
+// bonuses the player picked for their ability bonus choices.
+func applyRace(raceService raceServices.RaceService, character *models.Character) error {
+	race, err := raceService.GetRaceDetails(character.RaceID)
+	if err != nil {
+		if errors.Is(err, failure.ErrorNotFound) {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("race with ID %s does not exist", character.RaceID.String()))
+		}
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to load race %s: %w", character.RaceID.String(), err))
+	}
+
+	var subrace *raceModels.Subrace
+	if character.SubraceID != nil {
+		for i := range race.Subraces {
+			if race.Subraces[i].ID == *character.SubraceID {
+				subrace = &race.Subraces[i]
+			}
+		}
+		if subrace == nil {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("subrace with ID %s does not belong to race %s", character.SubraceID.String(), race.Name))
+		}
+	}
+
+	bonuses, err := raceModels.ResolveAbilityBonuses(race, subrace, character.AbilityBonusPicks)
+	if err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid ability bonus picks: %w", err))
+	}
+
+	character.ResetRacialFeatures()
+	if subrace == nil {
+		race.ApplyTo(character.RacialGrants())
+	} else {
+		raceModels.ResolveEffectiveRace(race, subrace).ApplyTo(character.RacialGrants())
+	}
+
+	// ApplyTo only grants the fixed bonuses, the chosen ones come from the picks.
+	for _, bonus := range bonuses {
+		for _, contribution := range bonus.Contributions {
+			if contribution.Choice {
+				character.ApplyAbilityBonus(bonus.Ability, contribution.Value)
+			}
+		}
+	}
+	return nil
+}
+
+func validateCharacter(character *models.Character) error {
+	if character.Name == "" {
+		return errors.New("character name cannot be empty")
+	}
+	if character.Level == 0 {
+		character.Level = minLevel
+	}
+	if character.Level < minLevel || character.Level > maxLevel {
+		return fmt.Errorf("level must be between %d and %d: %d", minLevel, maxLevel, character.Level)
+	}
+	if character.RaceID == uuid.Nil {
+		return errors.New("race ID cannot be empty")
+	}
+	if character.AdvancementMode == "" {
+		character.AdvancementMode = models.AdvancementExperience
+	}
+	if character.AdvancementMode != models.AdvancementExperience && character.AdvancementMode != models.AdvancementMilestone {
+		return fmt.Errorf("invalid advancement mode: %s", character.AdvancementMode)
+	}
+	if character.ExperiencePoints < 0 {
+		return fmt.Errorf("experience points cannot be negative: %d", character.ExperiencePoints)
+	}
+	if character.SubraceID != nil && *character.SubraceID == uuid.Nil {
+		character.SubraceID = nil
+	}
+	return validateAbilityScores(&character.BaseAbilityScores)
+}
+
+func validateAbilityScores(scores *models.AbilityScores) error {
+	for _, ability := range raceModels.Abilities {
+		if score := scores.Get(ability); score < minAbilityScore || score > maxAbilityScore {
+			return fmt.Errorf("%s score must be between %d and %d: %d", ability, minAbilityScore, maxAbilityScore, score)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type DraftService interface {
+	StartDraft() (*models.CharacterDraft, error)
+	GetDraft(id uuid.UUID) (*models.CharacterDraft, error)
+	ChooseRace(id uuid.UUID, step *models.DraftRaceStep) (*models.CharacterDraft, error)
+	ChooseClass(id uuid.UUID, step *models.DraftClassStep) (*models.CharacterDraft, error)
+	SetAbilityScores(id uuid.UUID, scores *models.AbilityScores) (*models.CharacterDraft, error)
+	ChooseBackground(id uuid.UUID, step *models.DraftBackgroundStep) (*models.CharacterDraft, error)
+	ChooseEquipment(id uuid.UUID, step *models.DraftEquipmentStep) (*models.CharacterDraft, error)
+	DescribeCharacter(id uuid.UUID, step *models.DraftDescriptionStep) (*models.CharacterDraft, error)
+	ChooseSkills(id uuid.UUID, step *models.DraftSkillsStep) (*models.CharacterDraft, error)
+	CalculateHitPoints(id uuid.UUID) (*models.CharacterDraft, error)
+	ChooseSpells(id uuid.UUID, step *models.DraftSpellsStep) (*models.CharacterDraft, error)
+	FinalizeDraft(id uuid.UUID) (*models.Character, error)
+	DiscardDraft(id uuid.UUID) error
+}
@@ -0,0 +1,370 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	backgroundServices "github.com/Casagrande-Lucas/dnd/internal/domain/background/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/repositories"
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	classServices "github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	proficiencyServices "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	rulesModels "github.com/Casagrande-Lucas/dnd/internal/domain/rules/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const (
+	minBaseAbilityScore = 3
+	maxBaseAbilityScore = 18
+)
+
+// draftServiceImpl is the concrete implementation of DraftService.
+type draftServiceImpl struct {
+	repo               repositories.DraftRepository
+	raceService        raceServices.RaceService
+	classService       classServices.ClassService
+	backgroundService  backgroundServices.BackgroundService
+	proficiencyService proficiencyServices.ProficiencyService
+}
+
+// NewDraftService creates a new instance of draftServiceImpl.
+func NewDraftService(
+	repo repositories.DraftRepository,
+	raceService raceServices.RaceService,
+	classService classServices.ClassService,
+	backgroundService backgroundServices.BackgroundService,
+	proficiencyService proficiencyServices.ProficiencyService,
+) DraftService {
+	return &draftServiceImpl{
+		repo:               repo,
+		raceService:        raceService,
+		classService:       classService,
+		backgroundService:  backgroundService,
+		proficiencyService: proficiencyService,
+	}
+}
+
+func (s *draftServiceImpl) StartDraft() (*models.CharacterDraft, error) {
+	draft := &models.CharacterDraft{
+		Status:         models.DraftStatusInProgress,
+		CompletedSteps: []string{},
+	}
+	if err := s.repo.CreateDraft(draft); err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to start character draft: %w", err))
+	}
+	draft.MissingSteps()
+	return draft, nil
+}
+
+func (s *draftServiceImpl) GetDraft(id uuid.UUID) (*models.CharacterDraft, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character draft ID: %s", id.String()))
+	}
+
+	draft, err := s.repo.GetDraftByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get character draft: %w", err))
+	}
+	draft.MissingSteps()
+	return draft, nil
+}
+
+func (s *draftServiceImpl) ChooseRace(id uuid.UUID, step *models.DraftRaceStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepRace, func(draft *models.CharacterDraft) error {
+		race, err := s.raceService.GetRaceDetails(step.RaceID)
+		if err != nil {
+			return failure.NotFoundAsBadRequest(err)
+		}
+
+		var subrace *raceModels.Subrace
+		if step.SubraceID != nil {
+			i := slices.IndexFunc(race.Subraces, func(subrace raceModels.Subrace) bool { return subrace.ID == *step.SubraceID })
+			if i < 0 {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("subrace with ID %s does not belong to race %s", step.SubraceID.String(), race.Name))
+			}
+			subrace = &race.Subraces[i]
+		} else if len(race.Subraces) > 0 {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("race %s requires choosing one of its subraces", race.Name))
+		}
+
+		if _, err := raceModels.ResolveAbilityBonuses(race, subrace, step.AbilityBonusPicks); err != nil {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid ability bonus picks: %w", err))
+		}
+
+		draft.RaceID = &race.ID
+		draft.SubraceID = step.SubraceID
+		draft.AbilityBonusPicks = step.AbilityBonusPicks
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) ChooseClass(id uuid.UUID, step *models.DraftClassStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepClass, func(draft *models.CharacterDraft) error {
+		class, err := s.classService.GetClassDetails(step.ClassID)
+		if err != nil {
+			return failure.NotFoundAsBadRequest(err)
+		}
+
+		draft.ClassID = &class.ID
+		draft.RequiresSpells = class.Spellcasting.CasterType != classModels.CasterTypeNone
+		if !draft.RequiresSpells {
+			draft.Spells = nil
+		}
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) SetAbilityScores(id uuid.UUID, scores *models.AbilityScores) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepAbilityScores, func(draft *models.CharacterDraft) error {
+		for _, ability := range raceModels.Abilities {
+			score := scores.Get(ability)
+			if score < minBaseAbilityScore || score > maxBaseAbilityScore {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("base %s score must be between %d and %d: %d", ability, minBaseAbilityScore, maxBaseAbilityScore, score))
+			}
+		}
+
+		draft.AbilityScores = *scores
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) ChooseBackground(id uuid.UUID, step *models.DraftBackgroundStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepBackground, func(draft *models.CharacterDraft) error {
+		background, err := s.backgroundService.GetBackgroundDetails(step.BackgroundID)
+		if err != nil {
+			return failure.NotFoundAsBadRequest(err)
+		}
+
+		draft.BackgroundID = &background.ID
+		if len(draft.Equipment) == 0 {
+			draft.Equipment = background.StartingEquipment
+		}
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) ChooseEquipment(id uuid.UUID, step *models.DraftEquipmentStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepEquipment, func(draft *models.CharacterDraft) error {
+		for _, item := range step.Equipment {
+			if strings.TrimSpace(item) == "" {
+				return failure.NewError(failure.ErrorBadRequest, errors.New("equipment item cannot be empty"))
+			}
+		}
+
+		draft.Equipment = step.Equipment
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) DescribeCharacter(id uuid.UUID, step *models.DraftDescriptionStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepDescription, func(draft *models.CharacterDraft) error {
+		if strings.TrimSpace(step.Name) == "" {
+			return failure.NewError(failure.ErrorBadRequest, errors.New("character name cannot be empty"))
+		}
+
+		draft.Name = step.Name
+		draft.Alignment = step.Alignment
+		draft.PersonalityTraits = step.PersonalityTraits
+		draft.Ideal = step.Ideal
+		draft.Bond = step.Bond
+		draft.Flaw = step.Flaw
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) ChooseSkills(id uuid.UUID, step *models.DraftSkillsStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepSkills, func(draft *models.CharacterDraft) error {
+		background, err := s.backgroundService.GetBackgroundDetails(*draft.BackgroundID)
+		if err != nil {
+			return failure.NotFoundAsBadRequest(err)
+		}
+
+		seen := make(map[uuid.UUID]bool)
+		for _, profID := range step.ProficiencyIDs {
+			if seen[profID] {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("skill %s chosen more than once", profID.String()))
+			}
+			seen[profID] = true
+
+			prof, err := s.proficiencyService.GetProficiencyDetails(profID)
+			if err != nil {
+				return failure.NotFoundAsBadRequest(err)
+			}
+			if prof.Category != raceModels.ProficiencyCategorySkill {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency '%s' is not a skill", prof.Name))
+			}
+			if slices.ContainsFunc(background.SkillProficiencies, func(granted raceModels.Proficiency) bool { return granted.ID == prof.ID }) {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("skill '%s' is already granted by background %s", prof.Name, background.Name))
+			}
+		}
+
+		draft.SkillProficiencyIDs = step.ProficiencyIDs
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) CalculateHitPoints(id uuid.UUID) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepHitPoints, func(draft *models.CharacterDraft) error {
+		class, err := s.classService.GetClassDetails(*draft.ClassID)
+		if err != nil {
+			return failure.NotFoundAsBadRequest(err)
+		}
+
+		scores, err := s.finalAbilityScores(draft)
+		if err != nil {
+			return err
+		}
+
+		draft.MaxHitPoints = rulesModels.CalcHP(class.HitDie, 1, models.Modifier(scores.Constitution))
+		draft.ArmorClass = rulesModels.CalcAC(scores, nil, nil, rulesModels.UnarmoredDefenseNone).Value
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) ChooseSpells(id uuid.UUID, step *models.DraftSpellsStep) (*models.CharacterDraft, error) {
+	return s.advance(id, models.StepSpells, func(draft *models.CharacterDraft) error {
+		if !draft.RequiresSpells {
+			return failure.NewError(failure.ErrorBadRequest, errors.New("the chosen class does not cast spells"))
+		}
+		for _, spell := range step.Spells {
+			if strings.TrimSpace(spell) == "" {
+				return failure.NewError(failure.ErrorBadRequest, errors.New("spell name cannot be empty"))
+			}
+		}
+
+		draft.Spells = step.Spells
+		return nil
+	})
+}
+
+func (s *draftServiceImpl) FinalizeDraft(id uuid.UUID) (*models.Character, error) {
+	draft, err := s.openDraft(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := draft.MissingSteps(); len(missing) > 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("character draft is missing steps: %s", strings.Join(missing, ", ")))
+	}
+
+	background, err := s.backgroundService.GetBackgroundDetails(*draft.BackgroundID)
+	if err != nil {
+		return nil, failure.NotFoundAsBadRequest(err)
+	}
+
+	character := &models.Character{
+		Name:              draft.Name,
+		Level:             1,
+		RaceID:            *draft.RaceID,
+		SubraceID:         draft.SubraceID,
+		ClassID:           draft.ClassID,
+		BackgroundID:      draft.BackgroundID,
+		Alignment:         draft.Alignment,
+		BaseAbilityScores: draft.AbilityScores,
+		AbilityBonusPicks: draft.AbilityBonusPicks,
+		MaxHitPoints:      draft.MaxHitPoints,
+		ArmorClass:        draft.ArmorClass,
+		Equipment:         draft.Equipment,
+		Spells:            draft.Spells,
+		PersonalityTraits: draft.PersonalityTraits,
+		Ideal:             draft.Ideal,
+		Bond:              draft.Bond,
+		Flaw:              draft.Flaw,
+	}
+
+	for _, lang := range background.Languages {
+		character.AddLanguage(lang)
+	}
+	for _, prof := range background.SkillProficiencies {
+		character.AddProficiency(prof)
+	}
+	for _, prof := range background.ToolProficiencies {
+		character.AddProficiency(prof)
+	}
+	for _, profID := range draft.SkillProficiencyIDs {
+		prof, err := s.proficiencyService.GetProficiencyDetails(profID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		character.AddProficiency(*prof)
+	}
+
+	if err := validateCharacter(character); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character data: %w", err))
+	}
+	if err := applyRace(s.raceService, character); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.FinalizeDraft(draft, character); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to finalize character draft: %w", err))
+	}
+	return character, nil
+}
+
+func (s *draftServiceImpl) DiscardDraft(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid character draft ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteDraft(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to discard character draft: %w", err))
+	}
+	return nil
+}
+
+// openDraft loads a draft that can still be edited.
+func (s *draftServiceImpl) openDraft(id uuid.UUID) (*models.CharacterDraft, error) {
+	draft, err := s.GetDraft(id)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status == models.DraftStatusFinalized {
+		return nil, failure.NewError(failure.ErrorConflict, fmt.Errorf("character draft %s is already finalized", id.String()))
+	}
+	return draft, nil
+}
+
+// advance runs a creation step once every required step before it is complete, then records it as done.
+func (s *draftServiceImpl) advance(id uuid.UUID, step string, apply func(draft *models.CharacterDraft) error) (*models.CharacterDraft, error) {
+	draft, err := s.openDraft(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := draft.MissingBefore(step); len(missing) > 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("step %s requires completing first: %s", step, strings.Join(missing, ", ")))
+	}
+
+	if err := apply(draft); err != nil {
+		return nil, err
+	}
+
+	draft.Complete(step)
+	if err := s.repo.UpdateDraft(draft); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to save character draft: %w", err))
+	}
+	draft.MissingSteps()
+	return draft, nil
+}
+
+// finalAbilityScores applies the draft's race and subrace bonuses, including the picked ones, to its base ability
+// scores.
+func (s *draftServiceImpl) finalAbilityScores(draft *models.CharacterDraft) (models.AbilityScores, error) {
+	preview := &models.Character{
+		RaceID:            *draft.RaceID,
+		SubraceID:         draft.SubraceID,
+		BaseAbilityScores: draft.AbilityScores,
+		AbilityBonusPicks: draft.AbilityBonusPicks,
+	}
+	if err := applyRace(s.raceService, preview); err != nil {
+		return models.AbilityScores{}, err
+	}
+	return preview.AbilityScores, nil
+}
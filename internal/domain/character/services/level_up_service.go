@@ -0,0 +1,14 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/google/uuid"
+)
+
+type LevelUpService interface {
+	PreviewLevelUp(id uuid.UUID) (*models.LevelUpPreview, error)
+	LevelUp(id uuid.UUID, request *models.LevelUpRequest) (*models.CharacterLevelUp, error)
+	RevertLevelUp(id uuid.UUID) (*models.Character, error)
+	GetLevelHistory(id uuid.UUID) ([]*models.CharacterLevelUp, error)
+	AwardExperience(id uuid.UUID, award *models.ExperienceAward) (*models.Character, error)
+}
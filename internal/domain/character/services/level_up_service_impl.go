@@ -0,0 +1,274 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/repositories"
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	classServices "github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// levelUpServiceImpl is the concrete implementation of LevelUpService.
+type levelUpServiceImpl struct {
+	repo             repositories.LevelUpRepository
+	characterService CharacterService
+	raceService      raceServices.RaceService
+	classService     classServices.ClassService
+}
+
+// NewLevelUpService creates a new instance of levelUpServiceImpl.
+func NewLevelUpService(
+	repo repositories.LevelUpRepository,
+	characterService CharacterService,
+	raceService raceServices.RaceService,
+	classService classServices.ClassService,
+) LevelUpService {
+	return &levelUpServiceImpl{
+		repo:             repo,
+		characterService: characterService,
+		raceService:      raceService,
+		classService:     classService,
+	}
+}
+
+func (s *levelUpServiceImpl) PreviewLevelUp(id uuid.UUID) (*models.LevelUpPreview, error) {
+	character, class, err := s.characterWithClass(id)
+	if err != nil {
+		return nil, err
+	}
+	return nextLevel(character, class)
+}
+
+func (s *levelUpServiceImpl) LevelUp(id uuid.UUID, request *models.LevelUpRequest) (*models.CharacterLevelUp, error) {
+	character, class, err := s.characterWithClass(id)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := nextLevel(character, class)
+	if err != nil {
+		return nil, err
+	}
+	if !preview.Eligible {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("character needs %d experience points to reach level %d, has %d", preview.ExperienceRequired, preview.Level, preview.ExperiencePoints))
+	}
+	if err := validateLevelUpRequest(request, preview); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid level up: %w", err))
+	}
+
+	levelUp := &models.CharacterLevelUp{
+		CharacterID:               character.ID,
+		ClassID:                   class.ID,
+		Level:                     preview.Level,
+		HitPointMethod:            request.HitPoints,
+		HitDie:                    class.HitDie,
+		AbilityIncreases:          request.AbilityIncreases,
+		Feat:                      request.Feat,
+		Features:                  preview.Features,
+		PreviousBaseAbilityScores: character.BaseAbilityScores,
+		PreviousAbilityScores:     character.AbilityScores,
+		PreviousMaxHitPoints:      character.MaxHitPoints,
+	}
+
+	previousConModifier := models.Modifier(character.AbilityScores.Constitution)
+	for _, increase := range request.AbilityIncreases {
+		character.BaseAbilityScores.ModifyScore(increase.Ability, increase.Amount)
+	}
+	if err := applyRace(s.raceService, character); err != nil {
+		return nil, err
+	}
+	for _, increase := range request.AbilityIncreases {
+		if score := character.AbilityScores.Get(increase.Ability); score > models.MaxImprovedAbilityScore {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s cannot be raised above %d: %d", increase.Ability, models.MaxImprovedAbilityScore, score))
+		}
+	}
+	if request.Feat != "" {
+		character.Feats = append(character.Feats, request.Feat)
+	}
+
+	switch request.HitPoints {
+	case models.HitPointsAverage:
+		levelUp.HitDieResult = models.AverageHitDie(class.HitDie)
+	case models.HitPointsRoll:
+		// Seeds are stored in a signed bigint column, so they are drawn from its non-negative range.
+		seed := uint64(rand.Int64())
+		if request.Seed != nil {
+			seed = *request.Seed
+		}
+		levelUp.Seed = &seed
+		levelUp.HitDieResult = dice.NewSeededRoller(seed).Roll(dice.MustParse(fmt.Sprintf("1d%d", class.HitDie))).Total
+	}
+
+	// A higher Constitution modifier also raises the hit points of every level already gained.
+	conModifier := models.Modifier(character.AbilityScores.Constitution)
+	levelUp.HitPointsGained = max(1, levelUp.HitDieResult+conModifier) + (conModifier-previousConModifier)*character.Level
+
+	character.Level = levelUp.Level
+	character.MaxHitPoints += levelUp.HitPointsGained
+
+	if err := s.repo.SaveLevelUp(character, levelUp); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to level up character: %w", err))
+	}
+	return levelUp, nil
+}
+
+func (s *levelUpServiceImpl) RevertLevelUp(id uuid.UUID) (*models.Character, error) {
+	character, err := s.characterService.GetCharacterDetails(id)
+	if err != nil {
+		return nil, err
+	}
+
+	levelUp, err := s.repo.GetLatestLevelUp(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to find the level up to revert: %w", err))
+	}
+	if levelUp.Level != character.Level {
+		return nil, failure.NewError(failure.ErrorConflict, fmt.Errorf("character is at level %d but its latest level up reached level %d", character.Level, levelUp.Level))
+	}
+
+	character.Level = levelUp.Level - 1
+	character.BaseAbilityScores = levelUp.PreviousBaseAbilityScores
+	character.AbilityScores = levelUp.PreviousAbilityScores
+	character.MaxHitPoints = levelUp.PreviousMaxHitPoints
+	if levelUp.Feat != "" {
+		if i := slices.Index(character.Feats, levelUp.Feat); i >= 0 {
+			character.Feats = slices.Delete(character.Feats, i, i+1)
+		}
+	}
+
+	if err := s.repo.RevertLevelUp(character, levelUp); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to revert level up: %w", err))
+	}
+	return character, nil
+}
+
+func (s *levelUpServiceImpl) GetLevelHistory(id uuid.UUID) ([]*models.CharacterLevelUp, error) {
+	if _, err := s.characterService.GetCharacterDetails(id); err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.GetLevelHistory(id)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get level history: %w", err))
+	}
+	return history, nil
+}
+
+func (s *levelUpServiceImpl) AwardExperience(id uuid.UUID, award *models.ExperienceAward) (*models.Character, error) {
+	if award.Amount <= 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("experience award must be positive: %d", award.Amount))
+	}
+
+	character, err := s.characterService.GetCharacterDetails(id)
+	if err != nil {
+		return nil, err
+	}
+	if character.AdvancementMode == models.AdvancementMilestone {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("character advances by milestone and does not track experience points"))
+	}
+
+	if err := s.repo.AddExperience(id, award.Amount); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to award experience: %w", err))
+	}
+	return s.characterService.GetCharacterDetails(id)
+}
+
+// characterWithClass loads a character together with the class it levels up in.
+func (s *levelUpServiceImpl) characterWithClass(id uuid.UUID) (*models.Character, *classModels.Class, error) {
+	character, err := s.characterService.GetCharacterDetails(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if character.ClassID == nil {
+		return nil, nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("character %s has no class to level up in", character.Name))
+	}
+
+	class, err := s.classService.GetClassDetails(*character.ClassID)
+	if err != nil {
+		return nil, nil, failure.NotFoundAsBadRequest(err)
+	}
+	return character, class, nil
+}
+
+// nextLevel describes what the character gains at its next level in the class.
+func nextLevel(character *models.Character, class *classModels.Class) (*models.LevelUpPreview, error) {
+	if character.Level >= classModels.MaxLevel {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("character is already at the maximum level %d", classModels.MaxLevel))
+	}
+
+	preview := &models.LevelUpPreview{
+		CharacterID:             character.ID,
+		Level:                   character.Level + 1,
+		AdvancementMode:         character.AdvancementMode,
+		ExperiencePoints:        character.ExperiencePoints,
+		Eligible:                true,
+		HitDie:                  class.HitDie,
+		AverageHitPoints:        max(1, models.AverageHitDie(class.HitDie)+models.Modifier(character.AbilityScores.Constitution)),
+		Features:                []models.LevelFeature{},
+		AbilityScoreImprovement: class.GrantsAbilityScoreImprovement(character.Level + 1),
+	}
+	if character.AdvancementMode != models.AdvancementMilestone {
+		preview.ExperienceRequired = models.ExperienceForLevel(preview.Level)
+		preview.Eligible = character.ExperiencePoints >= preview.ExperienceRequired
+	}
+	for _, feature := range class.AtLevel(preview.Level).Features {
+		preview.Features = append(preview.Features, models.LevelFeature{Name: feature.Name, Description: feature.Description})
+	}
+	return preview, nil
+}
+
+func validateLevelUpRequest(request *models.LevelUpRequest, preview *models.LevelUpPreview) error {
+	if request.HitPoints == "" {
+		request.HitPoints = models.HitPointsAverage
+	}
+	if request.HitPoints != models.HitPointsAverage && request.HitPoints != models.HitPointsRoll {
+		return fmt.Errorf("invalid hit points method: %s", request.HitPoints)
+	}
+	if request.Seed != nil && *request.Seed > math.MaxInt64 {
+		return fmt.Errorf("seed cannot exceed %d", int64(math.MaxInt64))
+	}
+
+	request.Feat = strings.TrimSpace(request.Feat)
+	choseIncreases := len(request.AbilityIncreases) > 0
+	choseFeat := request.Feat != ""
+	if !preview.AbilityScoreImprovement {
+		if choseIncreases || choseFeat {
+			return fmt.Errorf("level %d does not grant an ability score improvement or a feat", preview.Level)
+		}
+		return nil
+	}
+	if choseIncreases == choseFeat {
+		return fmt.Errorf("level %d requires choosing either ability score increases or a feat", preview.Level)
+	}
+
+	total := 0
+	seen := make(map[string]bool)
+	for _, increase := range request.AbilityIncreases {
+		if !slices.Contains(raceModels.Abilities, increase.Ability) {
+			return fmt.Errorf("invalid ability: %s", increase.Ability)
+		}
+		if seen[increase.Ability] {
+			return fmt.Errorf("duplicate ability increase: %s", increase.Ability)
+		}
+		seen[increase.Ability] = true
+		if increase.Amount < 1 {
+			return fmt.Errorf("%s increase must be positive: %d", increase.Ability, increase.Amount)
+		}
+		total += increase.Amount
+	}
+	if choseIncreases && total != models.AbilityScoreImprovementPoints {
+		return fmt.Errorf("ability score increases must add up to %d: %d", models.AbilityScoreImprovementPoints, total)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ClassController interface {
+	GetAllClasses(ctx *gin.Context)
+	GetClassByID(ctx *gin.Context)
+	CreateClass(ctx *gin.Context)
+	UpdateClass(ctx *gin.Context)
+	DeleteClass(ctx *gin.Context)
+	GetLevelTable(ctx *gin.Context)
+	GetClassLevel(ctx *gin.Context)
+}
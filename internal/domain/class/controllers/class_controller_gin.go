@@ -0,0 +1,235 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// classControllerGin is a concrete implementation of ClassController using the Gin framework.
+type classControllerGin struct {
+	service services.ClassService
+}
+
+// NewClassControllerGin creates a new instance of classControllerGin.
+func NewClassControllerGin(service services.ClassService) ClassController {
+	return &classControllerGin{
+		service: service,
+	}
+}
+
+// GetAllClasses godoc
+// @Summary      List all classes
+// @Description  Return all registered classes
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Success      200 {array}  models.Class
+// @Failure      500 {object} httperror.ErrorResponse
+// @Router       /classes [get]
+func (c *classControllerGin) GetAllClasses(ctx *gin.Context) {
+	classs, err := c.service.ListClasses()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, classs)
+}
+
+// GetClassByID godoc
+// @Summary      Get class by ID
+// @Description  Retrieve a class using the provided ID
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Class ID (UUID)"
+// @Success      200  {object}  models.Class
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /classes/{id} [get]
+func (c *classControllerGin) GetClassByID(ctx *gin.Context) {
+	id, err := parseClassID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	class, err := c.service.GetClassDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, class)
+}
+
+// CreateClass godoc
+// @Summary      Create class
+// @Description  Create a new class with its proficiencies and level features. Proficiencies and multiclass proficiencies link catalog proficiencies by ID or name; unknown ones are rejected.
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Param        class  body      models.Class  true  "Class info"
+// @Success      201    {object}  models.Class
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      409    {object}  httperror.ErrorResponse
+// @Failure      500    {object}  httperror.ErrorResponse
+// @Router       /classes [post]
+func (c *classControllerGin) CreateClass(ctx *gin.Context) {
+	var class models.Class
+	if err := ctx.ShouldBindJSON(&class); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterClass(&class); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, class)
+}
+
+// UpdateClass godoc
+// @Summary      Update class
+// @Description  Update an existing class, replacing its proficiencies and level features. Proficiencies and multiclass proficiencies link catalog proficiencies by ID or name; unknown ones are rejected.
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string        true  "Class ID (UUID)"
+// @Param        class  body      models.Class  true  "Class info"
+// @Success      200    {object}  models.Class
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Failure      409    {object}  httperror.ErrorResponse
+// @Router       /classes/{id} [put]
+func (c *classControllerGin) UpdateClass(ctx *gin.Context) {
+	id, err := parseClassID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var class models.Class
+	if err := ctx.ShouldBindJSON(&class); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateClassInfo(id, &class); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, class)
+}
+
+// DeleteClass godoc
+// @Summary      Delete class
+// @Description  Delete an existing class
+// @Tags         Classes
+// @Param        id   path      string  true  "Class ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /classes/{id} [delete]
+func (c *classControllerGin) DeleteClass(ctx *gin.Context) {
+	id, err := parseClassID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveClass(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetLevelTable godoc
+// @Summary      Get class progression
+// @Description  Return the proficiency bonus and features gained at every level from 1 to 20
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Class ID (UUID)"
+// @Success      200  {array}   models.ClassLevel
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /classes/{id}/levels [get]
+func (c *classControllerGin) GetLevelTable(ctx *gin.Context) {
+	id, err := parseClassID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	table, err := c.service.GetLevelTable(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, table)
+}
+
+// GetClassLevel godoc
+// @Summary      Get class level
+// @Description  Return the proficiency bonus and features a class gains at the given level
+// @Tags         Classes
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string   true  "Class ID (UUID)"
+// @Param        level  path      integer  true  "Class level (1-20)"
+// @Success      200    {object}  models.ClassLevel
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Router       /classes/{id}/levels/{level} [get]
+func (c *classControllerGin) GetClassLevel(ctx *gin.Context) {
+	id, err := parseClassID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	level, err := strconv.Atoi(ctx.Param("level"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid level: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	row, err := c.service.GetClassLevel(id, level)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, row)
+}
+
+// parseClassID reads the class ID path parameter.
+func parseClassID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class ID: %w", err))
+	}
+	return id, nil
+}
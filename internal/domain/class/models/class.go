@@ -0,0 +1,150 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+const (
+	CasterTypeNone  = "none"
+	CasterTypeFull  = "full"
+	CasterTypeHalf  = "half"
+	CasterTypeThird = "third"
+	CasterTypePact  = "pact"
+)
+
+const MaxLevel = 20
+
+// FeatureAbilityScoreImprovement is the name of the class feature granting an ability score improvement or a feat.
+const FeatureAbilityScoreImprovement = "Ability Score Improvement"
+
+// DefaultAbilityScoreImprovementLevels are the levels granting an ability score improvement to classes that do not
+// list the feature themselves.
+var DefaultAbilityScoreImprovementLevels = []int{4, 8, 12, 16, 19}
+
+type Class struct {
+	ID                       uuid.UUID                `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name                     string                   `json:"name" gorm:"unique;not null"`
+	Description              string                   `json:"description"`
+	HitDie                   int                      `json:"hit_die" gorm:"not null" example:"10"`
+	PrimaryAbility           string                   `json:"primary_ability" example:"strength"`
+	SavingThrowProficiencies []string                 `json:"saving_throw_proficiencies" gorm:"type:jsonb;serializer:json" example:"strength,constitution"`
+	Proficiencies            []models.Proficiency     `json:"proficiencies,omitempty" gorm:"many2many:class_proficiencies;constraint:OnDelete:CASCADE;"`
+	Spellcasting             ClassSpellcasting        `json:"spellcasting" gorm:"embedded;embeddedPrefix:spellcasting_"`
+	Features                 []ClassFeature           `json:"features,omitempty" gorm:"foreignKey:ClassID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	MulticlassPrerequisites  []MulticlassPrerequisite `json:"multiclass_prerequisites,omitempty" gorm:"type:jsonb;serializer:json"`
+	MulticlassProficiencies  []models.Proficiency     `json:"multiclass_proficiencies,omitempty" gorm:"many2many:class_multiclass_proficiencies;constraint:OnDelete:CASCADE;"`
+	MulticlassSkillChoices   int                      `json:"multiclass_skill_choices,omitempty" example:"1"`
+}
+
+// MulticlassPrerequisite requires a minimum score in at least one of Abilities before a character can enter or leave
+// the class by multiclassing. A class lists one prerequisite per requirement, all of which must be met.
+type MulticlassPrerequisite struct {
+	Abilities []string `json:"abilities" example:"strength,dexterity"`
+	Minimum   int      `json:"minimum" example:"13"`
+}
+
+// ClassSpellcasting describes how a class casts spells. Classes without spellcasting use CasterTypeNone.
+type ClassSpellcasting struct {
+	CasterType string `json:"caster_type" gorm:"not null;default:none" enums:"none,full,half,third,pact" example:"none"`
+	Ability    string `json:"ability,omitempty" example:"intelligence"`
+}
+
+type ClassFeature struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ClassID     uuid.UUID `json:"class_id" gorm:"type:uuid;not null;index" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Level       int       `json:"level" gorm:"not null" example:"5"`
+	Name        string    `json:"name" gorm:"not null" example:"Extra Attack"`
+	Description string    `json:"description"`
+}
+
+// ClassLevel is one row of a class progression table.
+type ClassLevel struct {
+	Level            int            `json:"level" example:"5"`
+	ProficiencyBonus int            `json:"proficiency_bonus" example:"3"`
+	Features         []ClassFeature `json:"features"`
+}
+
+// ProficiencyBonus returns the proficiency bonus of a character of the given total level.
+func ProficiencyBonus(level int) int {
+	if level < 1 {
+		return 2
+	}
+	return 2 + (level-1)/4
+}
+
+// LevelTable returns the class progression from level 1 to MaxLevel.
+func (c *Class) LevelTable() []ClassLevel {
+	table := make([]ClassLevel, 0, MaxLevel)
+	for level := 1; level <= MaxLevel; level++ {
+		table = append(table, c.AtLevel(level))
+	}
+	return table
+}
+
+// AtLevel returns the proficiency bonus and the features gained at exactly the given level.
+func (c *Class) AtLevel(level int) ClassLevel {
+	row := ClassLevel{
+		Level:            level,
+		ProficiencyBonus: ProficiencyBonus(level),
+		Features:         []ClassFeature{},
+	}
+	for _, feature := range c.Features {
+		if feature.Level == level {
+			row.Features = append(row.Features, feature)
+		}
+	}
+	return row
+}
+
+// GrantsAbilityScoreImprovement reports whether reaching the given level grants an ability score improvement. Classes
+// listing the feature, such as the fighter and rogue with their extra improvements, use their own levels.
+func (c *Class) GrantsAbilityScoreImprovement(level int) bool {
+	listed := false
+	for _, feature := range c.Features {
+		if strings.EqualFold(feature.Name, FeatureAbilityScoreImprovement) {
+			if feature.Level == level {
+				return true
+			}
+			listed = true
+		}
+	}
+	return !listed && slices.Contains(DefaultAbilityScoreImprovementLevels, level)
+}
+
+// MetBy reports whether the ability scores returned by score satisfy the prerequisite.
+func (p MulticlassPrerequisite) MetBy(score func(ability string) int) bool {
+	return slices.ContainsFunc(p.Abilities, func(ability string) bool { return score(ability) >= p.Minimum })
+}
+
+// String describes the prerequisite, such as "strength 13 or dexterity 13".
+func (p MulticlassPrerequisite) String() string {
+	requirements := make([]string, 0, len(p.Abilities))
+	for _, ability := range p.Abilities {
+		requirements = append(requirements, fmt.Sprintf("%s %d", ability, p.Minimum))
+	}
+	return strings.Join(requirements, " or ")
+}
+
+// MaxSpellLevel returns the highest spell level a character of the given class level can learn, or 0 when only
+// cantrips are available. Pact casters gain their Mystic Arcanum levels on the same schedule as full casters.
+func (s ClassSpellcasting) MaxSpellLevel(level int) int {
+	var spellLevel int
+	switch s.CasterType {
+	case CasterTypeFull, CasterTypePact:
+		spellLevel = (level + 1) / 2
+	case CasterTypeHalf:
+		if level >= 2 {
+			spellLevel = (level + 3) / 4
+		}
+	case CasterTypeThird:
+		if level >= 3 {
+			spellLevel = (level + 5) / 6
+		}
+	}
+	return min(spellLevel, 9)
+}
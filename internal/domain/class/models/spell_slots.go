@@ -0,0 +1,83 @@
+package models
+
+// spellSlotTable holds, at index casterLevel-1, the spell slots of each spell level from 1 to 9.
+var spellSlotTable = [MaxLevel][9]int{
+	{2},
+	{3},
+	{4, 2},
+	{4, 3},
+	{4, 3, 2},
+	{4, 3, 3},
+	{4, 3, 3, 1},
+	{4, 3, 3, 2},
+	{4, 3, 3, 3, 1},
+	{4, 3, 3, 3, 2},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 2, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 1, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 1, 1, 1},
+	{4, 3, 3, 3, 3, 2, 2, 1, 1},
+}
+
+// SpellSlots returns the number of spell slots of each spell level, starting at level 1, for the given caster level.
+// Spell levels without slots are left out, so a caster level of 0 has none.
+func SpellSlots(casterLevel int) []int {
+	slots := []int{}
+	if casterLevel < 1 {
+		return slots
+	}
+	for _, count := range spellSlotTable[min(casterLevel, MaxLevel)-1] {
+		if count == 0 {
+			break
+		}
+		slots = append(slots, count)
+	}
+	return slots
+}
+
+// PactMagicSlots returns the number of pact magic slots and their spell level for a pact caster of the given class
+// level.
+func PactMagicSlots(level int) (slots int, slotLevel int) {
+	switch {
+	case level < 1:
+		return 0, 0
+	case level == 1:
+		return 1, 1
+	case level <= 10:
+		return 2, (level + 1) / 2
+	case level <= 16:
+		return 3, 5
+	default:
+		return 4, 5
+	}
+}
+
+// CasterLevel returns the levels the class contributes to a character's spellcaster level. A multiclassed
+// spellcaster rounds half and third casters down, while a single class rounds up from the level it gains
+// spellcasting. Pact casters never contribute: their pact magic slots are separate.
+func (s ClassSpellcasting) CasterLevel(level int, multiclass bool) int {
+	switch s.CasterType {
+	case CasterTypeFull:
+		return level
+	case CasterTypeHalf:
+		if multiclass {
+			return level / 2
+		}
+		if level >= 2 {
+			return (level + 1) / 2
+		}
+	case CasterTypeThird:
+		if multiclass {
+			return level / 3
+		}
+		if level >= 3 {
+			return (level + 2) / 3
+		}
+	}
+	return 0
+}
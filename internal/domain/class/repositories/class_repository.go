@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/google/uuid"
+)
+
+type ClassRepository interface {
+	GetAllClasses() ([]*models.Class, error)
+	GetClassByID(id uuid.UUID) (*models.Class, error)
+	GetClassByName(name string) (*models.Class, error)
+	CreateClass(class *models.Class) error
+	UpdateClass(id uuid.UUID, class *models.Class) error
+	DeleteClass(id uuid.UUID) error
+}
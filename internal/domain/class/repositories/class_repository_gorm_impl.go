@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// classRepositoryGormImpl is a concrete implementation of the ClassRepository interface using GORM.
+type classRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormClassRepository creates a new instance of classRepositoryGormImpl.
+func NewGormClassRepository(db *gorm.DB) ClassRepository {
+	return &classRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// orderedFeatures preloads class features in progression order.
+func orderedFeatures(db *gorm.DB) *gorm.DB {
+	return db.Order("level").Order("name")
+}
+
+// GetAllClasses retrieves all classes from the database, including their related entities.
+func (r *classRepositoryGormImpl) GetAllClasses() ([]*models.Class, error) {
+	var classes []*models.Class
+	if err := r.db.Preload("Proficiencies").
+		Preload("MulticlassProficiencies").
+		Preload("Features", orderedFeatures).
+		Order("name").
+		Find(&classes).Error; err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
+
+// GetClassByID retrieves a class by its ID, including its related entities.
+func (r *classRepositoryGormImpl) GetClassByID(id uuid.UUID) (*models.Class, error) {
+	var class models.Class
+	if err := r.db.Preload("Proficiencies").
+		Preload("MulticlassProficiencies").
+		Preload("Features", orderedFeatures).
+		First(&class, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("class with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &class, nil
+}
+
+// GetClassByName retrieves a class by its name, including its related entities.
+func (r *classRepositoryGormImpl) GetClassByName(name string) (*models.Class, error) {
+	var class models.Class
+	if err := r.db.Preload("Proficiencies").
+		Preload("MulticlassProficiencies").
+		Preload("Features", orderedFeatures).
+		Where("name = ?", name).
+		First(&class).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("class with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &class, nil
+}
+
+// CreateClass adds a new class to the database along with its features, linking its existing proficiencies.
+func (r *classRepositoryGormImpl) CreateClass(class *models.Class) error {
+	return r.db.Omit("Proficiencies.*", "MulticlassProficiencies.*").Create(class).Error
+}
+
+// UpdateClass updates an existing class's details in the database, replacing its proficiencies and features.
+func (r *classRepositoryGormImpl) UpdateClass(id uuid.UUID, class *models.Class) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingClass models.Class
+	if err := tx.First(&existingClass, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("class with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	class.ID = existingClass.ID
+
+	if err := tx.Model(&existingClass).Omit("Proficiencies.*").Association("Proficiencies").Replace(class.Proficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingClass).Omit("MulticlassProficiencies.*").Association("MulticlassProficiencies").Replace(class.MulticlassProficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Where("class_id = ?", id).Delete(&models.ClassFeature{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i := range class.Features {
+		class.Features[i].ID = uuid.Nil
+		class.Features[i].ClassID = id
+	}
+	if len(class.Features) > 0 {
+		if err := tx.Create(&class.Features).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Omit("Proficiencies", "MulticlassProficiencies", "Features").Save(class).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteClass removes a class and its features from the database.
+func (r *classRepositoryGormImpl) DeleteClass(id uuid.UUID) error {
+	result := r.db.Delete(&models.Class{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("class with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/google/uuid"
+)
+
+type ClassService interface {
+	ListClasses() ([]*models.Class, error)
+	GetClassDetails(id uuid.UUID) (*models.Class, error)
+	RegisterClass(class *models.Class) error
+	UpdateClassInfo(id uuid.UUID, class *models.Class) error
+	RemoveClass(id uuid.UUID) error
+	GetLevelTable(id uuid.UUID) ([]models.ClassLevel, error)
+	GetClassLevel(id uuid.UUID, level int) (*models.ClassLevel, error)
+}
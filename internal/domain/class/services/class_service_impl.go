@@ -0,0 +1,205 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/repositories"
+	proficiencyServices "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const maxAbilityScore = 30
+
+var (
+	validHitDice     = []int{6, 8, 10, 12}
+	validCasterTypes = []string{
+		models.CasterTypeNone,
+		models.CasterTypeFull,
+		models.CasterTypeHalf,
+		models.CasterTypeThird,
+		models.CasterTypePact,
+	}
+)
+
+// classServiceImpl is the concrete implementation of ClassService.
+type classServiceImpl struct {
+	repo               repositories.ClassRepository
+	proficiencyService proficiencyServices.ProficiencyService
+}
+
+// NewClassService creates a new instance of classServiceImpl.
+func NewClassService(repo repositories.ClassRepository, proficiencyService proficiencyServices.ProficiencyService) ClassService {
+	return &classServiceImpl{
+		repo:               repo,
+		proficiencyService: proficiencyService,
+	}
+}
+
+func (s *classServiceImpl) ListClasses() ([]*models.Class, error) {
+	classes, err := s.repo.GetAllClasses()
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list classes: %w", err))
+	}
+	return classes, nil
+}
+
+func (s *classServiceImpl) GetClassDetails(id uuid.UUID) (*models.Class, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class ID: %s", id.String()))
+	}
+
+	class, err := s.repo.GetClassByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get class details by ID: %w", err))
+	}
+	return class, nil
+}
+
+func (s *classServiceImpl) RegisterClass(class *models.Class) error {
+	if err := validateClass(class); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class data: %w", err))
+	}
+	if err := s.resolveClassProficiencies(class); err != nil {
+		return err
+	}
+
+	existingClass, _ := s.repo.GetClassByName(class.Name)
+	if existingClass != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("class with name '%s' already exists", class.Name))
+	}
+
+	if err := s.repo.CreateClass(class); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register class: %w", err))
+	}
+	return nil
+}
+
+func (s *classServiceImpl) UpdateClassInfo(id uuid.UUID, class *models.Class) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class ID: %s", id.String()))
+	}
+
+	if err := validateClass(class); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class data: %w", err))
+	}
+	if err := s.resolveClassProficiencies(class); err != nil {
+		return err
+	}
+
+	duplicateClass, _ := s.repo.GetClassByName(class.Name)
+	if duplicateClass != nil && duplicateClass.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("class with name '%s' already exists", class.Name))
+	}
+
+	if err := s.repo.UpdateClass(id, class); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update class info: %w", err))
+	}
+	return nil
+}
+
+func (s *classServiceImpl) RemoveClass(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteClass(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove class: %w", err))
+	}
+	return nil
+}
+
+func (s *classServiceImpl) GetLevelTable(id uuid.UUID) ([]models.ClassLevel, error) {
+	class, err := s.GetClassDetails(id)
+	if err != nil {
+		return nil, err
+	}
+	return class.LevelTable(), nil
+}
+
+func (s *classServiceImpl) GetClassLevel(id uuid.UUID, level int) (*models.ClassLevel, error) {
+	if level < 1 || level > models.MaxLevel {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("level must be between 1 and %d: %d", models.MaxLevel, level))
+	}
+
+	class, err := s.GetClassDetails(id)
+	if err != nil {
+		return nil, err
+	}
+
+	row := class.AtLevel(level)
+	return &row, nil
+}
+
+// resolveClassProficiencies links the proficiencies and multiclass proficiencies of a class to their catalog entries.
+func (s *classServiceImpl) resolveClassProficiencies(class *models.Class) error {
+	if err := s.proficiencyService.ResolveProficiencies(class.Proficiencies); err != nil {
+		return err
+	}
+	return s.proficiencyService.ResolveProficiencies(class.MulticlassProficiencies)
+}
+
+func validateClass(class *models.Class) error {
+	if class.Name == "" {
+		return errors.New("class name cannot be empty")
+	}
+	if !slices.Contains(validHitDice, class.HitDie) {
+		return fmt.Errorf("invalid hit die: d%d", class.HitDie)
+	}
+	if class.PrimaryAbility != "" && !slices.Contains(raceModels.Abilities, class.PrimaryAbility) {
+		return fmt.Errorf("invalid primary ability: %s", class.PrimaryAbility)
+	}
+
+	seen := make(map[string]bool)
+	for _, ability := range class.SavingThrowProficiencies {
+		if !slices.Contains(raceModels.Abilities, ability) {
+			return fmt.Errorf("invalid saving throw ability: %s", ability)
+		}
+		if seen[ability] {
+			return fmt.Errorf("duplicate saving throw ability: %s", ability)
+		}
+		seen[ability] = true
+	}
+
+	if class.Spellcasting.CasterType == "" {
+		class.Spellcasting.CasterType = models.CasterTypeNone
+	}
+	if !slices.Contains(validCasterTypes, class.Spellcasting.CasterType) {
+		return fmt.Errorf("invalid caster type: %s", class.Spellcasting.CasterType)
+	}
+	if class.Spellcasting.CasterType != models.CasterTypeNone && !slices.Contains(raceModels.Abilities, class.Spellcasting.Ability) {
+		return fmt.Errorf("invalid spellcasting ability: %s", class.Spellcasting.Ability)
+	}
+
+	for _, prerequisite := range class.MulticlassPrerequisites {
+		if len(prerequisite.Abilities) == 0 {
+			return errors.New("multiclass prerequisite must name at least one ability")
+		}
+		for _, ability := range prerequisite.Abilities {
+			if !slices.Contains(raceModels.Abilities, ability) {
+				return fmt.Errorf("invalid multiclass prerequisite ability: %s", ability)
+			}
+		}
+		if prerequisite.Minimum < 1 || prerequisite.Minimum > maxAbilityScore {
+			return fmt.Errorf("multiclass prerequisite minimum must be between 1 and %d: %d", maxAbilityScore, prerequisite.Minimum)
+		}
+	}
+	if class.MulticlassSkillChoices < 0 {
+		return fmt.Errorf("multiclass skill choices cannot be negative: %d", class.MulticlassSkillChoices)
+	}
+
+	for _, feature := range class.Features {
+		if feature.Name == "" {
+			return errors.New("class feature name cannot be empty")
+		}
+		if feature.Level < 1 || feature.Level > models.MaxLevel {
+			return fmt.Errorf("class feature '%s' level must be between 1 and %d: %d", feature.Name, models.MaxLevel, feature.Level)
+		}
+	}
+
+	return nil
+}
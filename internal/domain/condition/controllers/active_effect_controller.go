@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ActiveEffectController interface {
+	GetAllEffects(ctx *gin.Context)
+	GetEffectByID(ctx *gin.Context)
+	ApplyEffect(ctx *gin.Context)
+	DeleteEffect(ctx *gin.Context)
+	AdvanceTime(ctx *gin.Context)
+	EndConcentration(ctx *gin.Context)
+	GetEffectiveStats(ctx *gin.Context)
+}
@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// activeEffectControllerGin is a concrete implementation of ActiveEffectController using the Gin framework.
+type activeEffectControllerGin struct {
+	service services.ActiveEffectService
+}
+
+// NewActiveEffectControllerGin creates a new instance of activeEffectControllerGin.
+func NewActiveEffectControllerGin(service services.ActiveEffectService) ActiveEffectController {
+	return &activeEffectControllerGin{
+		service: service,
+	}
+}
+
+// GetAllEffects godoc
+// @Summary      List active effects
+// @Description  Return the active effects with their conditions, oldest first, optionally filtered
+// @Tags         Active Effects
+// @Accept       json
+// @Produce      json
+// @Param        target_id      query     string  false  "Target ID (UUID)"
+// @Param        source_id      query     string  false  "Source ID (UUID)"
+// @Param        condition_id   query     string  false  "Condition ID (UUID)"
+// @Param        concentration  query     bool    false  "Effects that depend on the concentration of their source"
+// @Success      200            {array}   models.ActiveEffect
+// @Failure      400            {object}  httperror.ErrorResponse
+// @Failure      500            {object}  httperror.ErrorResponse
+// @Router       /effects [get]
+func (c *activeEffectControllerGin) GetAllEffects(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	effects, err := c.service.ListEffects(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, effects)
+}
+
+// GetEffectByID godoc
+// @Summary      Get active effect by ID
+// @Description  Retrieve an active effect using the provided ID
+// @Tags         Active Effects
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Active effect ID (UUID)"
+// @Success      200  {object}  models.ActiveEffect
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /effects/{id} [get]
+func (c *activeEffectControllerGin) GetEffectByID(ctx *gin.Context) {
+	id, err := parseEffectID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	effect, err := c.service.GetEffect(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, effect)
+}
+
+// ApplyEffect godoc
+// @Summary      Apply effect
+// @Description  Apply a condition of the catalog or a custom effect to one or more targets. An effect a target already has is replaced. Starting a concentration effect ends the previous concentration of its source, and incapacitating a target ends the target's own concentration. Timed durations last at most a year of game time.
+// @Tags         Active Effects
+// @Accept       json
+// @Produce      json
+// @Param        effect  body      models.ApplyEffectRequest  true  "Effect to apply"
+// @Success      201     {object}  models.ApplyEffectResult
+// @Failure      400     {object}  httperror.ErrorResponse
+// @Failure      500     {object}  httperror.ErrorResponse
+// @Router       /effects [post]
+func (c *activeEffectControllerGin) ApplyEffect(ctx *gin.Context) {
+	var request models.ApplyEffectRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.ApplyEffect(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// DeleteEffect godoc
+// @Summary      Remove active effect
+// @Description  End an active effect before it expires
+// @Tags         Active Effects
+// @Param        id   path      string  true  "Active effect ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /effects/{id} [delete]
+func (c *activeEffectControllerGin) DeleteEffect(ctx *gin.Context) {
+	id, err := parseEffectID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveEffect(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// AdvanceTime godoc
+// @Summary      Advance game time
+// @Description  Count down the timed effects of the given targets, or of every target, by the given rounds, minutes and hours, at most a year of game time in total. Effects whose duration runs out expire and are removed.
+// @Tags         Active Effects
+// @Accept       json
+// @Produce      json
+// @Param        time  body      models.AdvanceTimeRequest  true  "Time to advance"
+// @Success      200   {object}  models.AdvanceTimeResult
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      500   {object}  httperror.ErrorResponse
+// @Router       /effects/advance [post]
+func (c *activeEffectControllerGin) AdvanceTime(ctx *gin.Context) {
+	var request models.AdvanceTimeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.AdvanceTime(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// EndConcentration godoc
+// @Summary      End concentration
+// @Description  Break the concentration of a source, ending every effect that depends on it
+// @Tags         Active Effects
+// @Produce      json
+// @Param        sourceID  path      string  true  "Source ID (UUID)"
+// @Success      200       {array}   models.ActiveEffect
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      500       {object}  httperror.ErrorResponse
+// @Router       /effects/concentration/{sourceID} [delete]
+func (c *activeEffectControllerGin) EndConcentration(ctx *gin.Context) {
+	sourceID, err := uuid.Parse(ctx.Param("sourceID"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid source ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	ended, err := c.service.EndConcentration(sourceID)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, ended)
+}
+
+// GetEffectiveStats godoc
+// @Summary      Get effective speed and size
+// @Description  Apply the active effects of a target to the base speed and size of its race and subrace, for example halving speed at the second level of exhaustion
+// @Tags         Active Effects
+// @Produce      json
+// @Param        target_id   query     string  true  "Target ID (UUID)"
+// @Param        race_id     query     string  true  "Race ID (UUID)"
+// @Param        subrace_id  query     string  false  "Subrace ID (UUID)"
+// @Success      200         {object}  models.EffectiveStats
+// @Failure      400         {object}  httperror.ErrorResponse
+// @Failure      500         {object}  httperror.ErrorResponse
+// @Router       /effects/effective [get]
+func (c *activeEffectControllerGin) GetEffectiveStats(ctx *gin.Context) {
+	targetID, err := uuid.Parse(ctx.Query("target_id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid target ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	raceID, err := uuid.Parse(ctx.Query("race_id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var subraceID *uuid.UUID
+	if value := ctx.Query("subrace_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid subrace ID: %w", err)))
+			ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+			return
+		}
+		subraceID = &id
+	}
+
+	stats, err := c.service.GetEffectiveStats(targetID, raceID, subraceID)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, stats)
+}
+
+// parseEffectID reads the active effect ID path parameter.
+func parseEffectID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect ID: %w", err))
+	}
+	return id, nil
+}
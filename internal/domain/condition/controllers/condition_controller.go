@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ConditionController interface {
+	GetAllConditions(ctx *gin.Context)
+	GetConditionByID(ctx *gin.Context)
+	CreateCondition(ctx *gin.Context)
+	UpdateCondition(ctx *gin.Context)
+	DeleteCondition(ctx *gin.Context)
+	InstallStandardConditions(ctx *gin.Context)
+}
@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// conditionControllerGin is a concrete implementation of ConditionController using the Gin framework.
+type conditionControllerGin struct {
+	service services.ConditionService
+}
+
+// NewConditionControllerGin creates a new instance of conditionControllerGin.
+func NewConditionControllerGin(service services.ConditionService) ConditionController {
+	return &conditionControllerGin{
+		service: service,
+	}
+}
+
+// GetAllConditions godoc
+// @Summary      List all conditions
+// @Description  Return the conditions of the catalog ordered by name, optionally filtered
+// @Tags         Conditions
+// @Accept       json
+// @Produce      json
+// @Param        name            query     string  false  "Case-insensitive name fragment"
+// @Param        leveled         query     bool    false  "Conditions with levels, such as exhaustion"
+// @Param        incapacitating  query     bool    false  "Conditions that incapacitate"
+// @Success      200             {array}   models.Condition
+// @Failure      400             {object}  httperror.ErrorResponse
+// @Failure      500             {object}  httperror.ErrorResponse
+// @Router       /conditions [get]
+func (c *conditionControllerGin) GetAllConditions(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	conditions, err := c.service.ListConditions(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, conditions)
+}
+
+// GetConditionByID godoc
+// @Summary      Get condition by ID
+// @Description  Retrieve a condition using the provided ID
+// @Tags         Conditions
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Condition ID (UUID)"
+// @Success      200  {object}  models.Condition
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /conditions/{id} [get]
+func (c *conditionControllerGin) GetConditionByID(ctx *gin.Context) {
+	id, err := parseConditionID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	condition, err := c.service.GetConditionDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, condition)
+}
+
+// CreateCondition godoc
+// @Summary      Create condition
+// @Description  Create a new condition with its structured mechanics
+// @Tags         Conditions
+// @Accept       json
+// @Produce      json
+// @Param        condition  body      models.Condition  true  "Condition info"
+// @Success      201        {object}  models.Condition
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Failure      500        {object}  httperror.ErrorResponse
+// @Router       /conditions [post]
+func (c *conditionControllerGin) CreateCondition(ctx *gin.Context) {
+	var condition models.Condition
+	if err := ctx.ShouldBindJSON(&condition); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterCondition(&condition); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, condition)
+}
+
+// UpdateCondition godoc
+// @Summary      Update condition
+// @Description  Update an existing condition
+// @Tags         Conditions
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string            true  "Condition ID (UUID)"
+// @Param        condition  body      models.Condition  true  "Condition info"
+// @Success      200        {object}  models.Condition
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Router       /conditions/{id} [put]
+func (c *conditionControllerGin) UpdateCondition(ctx *gin.Context) {
+	id, err := parseConditionID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var condition models.Condition
+	if err := ctx.ShouldBindJSON(&condition); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateConditionInfo(id, &condition); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, condition)
+}
+
+// DeleteCondition godoc
+// @Summary      Delete condition
+// @Description  Delete an existing condition and every active effect of it
+// @Tags         Conditions
+// @Param        id   path      string  true  "Condition ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /conditions/{id} [delete]
+func (c *conditionControllerGin) DeleteCondition(ctx *gin.Context) {
+	id, err := parseConditionID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveCondition(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// InstallStandardConditions godoc
+// @Summary      Install standard conditions
+// @Description  Add the conditions of the System Reference Document that the catalog does not have yet, matched by name
+// @Tags         Conditions
+// @Produce      json
+// @Success      201  {array}   models.Condition
+// @Failure      500  {object}  httperror.ErrorResponse
+// @Router       /conditions/standard [post]
+func (c *conditionControllerGin) InstallStandardConditions(ctx *gin.Context) {
+	conditions, err := c.service.InstallStandardConditions()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, conditions)
+}
+
+// parseConditionID reads the condition ID path parameter.
+func parseConditionID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition ID: %w", err))
+	}
+	return id, nil
+}
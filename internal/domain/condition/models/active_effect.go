@@ -0,0 +1,141 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Units of an effect duration. Effects lasting until removed never expire on their own.
+const (
+	DurationRounds       = "rounds"
+	DurationMinutes      = "minutes"
+	DurationHours        = "hours"
+	DurationUntilRemoved = "until_removed"
+)
+
+// DurationUnits lists the accepted duration units.
+var DurationUnits = []string{DurationRounds, DurationMinutes, DurationHours, DurationUntilRemoved}
+
+// A round lasts 6 seconds, so a minute is 10 rounds and an hour 600.
+const (
+	RoundsPerMinute = 10
+	RoundsPerHour   = 60 * RoundsPerMinute
+)
+
+// MaxDurationRounds bounds effect durations and time advances to a year of game time, which also keeps the rounds
+// they add up to from overflowing. Longer effects last until removed.
+const MaxDurationRounds = 365 * 24 * RoundsPerHour
+
+// ActiveEffect is a condition or a custom effect currently affecting a target, usually a character. Its Mechanics are
+// added to those of its condition. RemainingRounds counts down as game time advances and the effect expires when it
+// reaches 0; it is nil for effects lasting until removed. A Concentration effect ends when its source stops
+// concentrating, starts concentrating on something else or is incapacitated.
+type ActiveEffect struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TargetID        uuid.UUID  `json:"target_id" gorm:"type:uuid;not null;index" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConditionID     *uuid.UUID `json:"condition_id,omitempty" gorm:"type:uuid;index" swaggertype:"string" format:"uuid"`
+	Condition       *Condition `json:"condition,omitempty" gorm:"foreignKey:ConditionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name            string     `json:"name" gorm:"not null" example:"Restrained"`
+	Level           int        `json:"level,omitempty" example:"1"`
+	Mechanics       Mechanics  `json:"mechanics" gorm:"type:jsonb;serializer:json"`
+	Duration        Duration   `json:"duration" gorm:"embedded;embeddedPrefix:duration_"`
+	RemainingRounds *int       `json:"remaining_rounds,omitempty" example:"10"`
+	SourceID        *uuid.UUID `json:"source_id,omitempty" gorm:"type:uuid;index" swaggertype:"string" format:"uuid"`
+	Concentration   bool       `json:"concentration"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// Duration is how long an effect lasts.
+type Duration struct {
+	Amount int    `json:"amount,omitempty" example:"1"`
+	Unit   string `json:"unit" enums:"rounds,minutes,hours,until_removed" example:"minutes"`
+}
+
+// ApplyEffectRequest applies a condition of the catalog or a custom effect to one or more targets. Name is taken from
+// the condition when one is given and is required otherwise. Applying an effect a target already has, by name,
+// replaces it.
+type ApplyEffectRequest struct {
+	TargetIDs     []uuid.UUID `json:"target_ids" binding:"required" swaggertype:"array,string"`
+	ConditionID   *uuid.UUID  `json:"condition_id,omitempty" swaggertype:"string" format:"uuid"`
+	Name          string      `json:"name,omitempty" example:"Slow"`
+	Level         int         `json:"level,omitempty" example:"1"`
+	Mechanics     Mechanics   `json:"mechanics"`
+	Duration      Duration    `json:"duration" binding:"required"`
+	SourceID      *uuid.UUID  `json:"source_id,omitempty" swaggertype:"string" format:"uuid"`
+	Concentration bool        `json:"concentration,omitempty"`
+}
+
+// ApplyEffectResult lists the effects applied and those that ended because they were replaced or lost their
+// concentration.
+type ApplyEffectResult struct {
+	Applied []*ActiveEffect `json:"applied"`
+	Ended   []*ActiveEffect `json:"ended"`
+}
+
+// AdvanceTimeRequest advances game time for the effects of the given targets, or of every target when none is given.
+type AdvanceTimeRequest struct {
+	TargetIDs []uuid.UUID `json:"target_ids,omitempty" swaggertype:"array,string"`
+	Rounds    int         `json:"rounds,omitempty" example:"1"`
+	Minutes   int         `json:"minutes,omitempty"`
+	Hours     int         `json:"hours,omitempty"`
+}
+
+// AdvanceTimeResult reports how many rounds passed and the effects that expired.
+type AdvanceTimeResult struct {
+	Rounds  int             `json:"rounds" example:"1"`
+	Expired []*ActiveEffect `json:"expired"`
+}
+
+// EffectiveStats are the speed and size of a target's race after its active effects.
+type EffectiveStats struct {
+	TargetID  uuid.UUID       `json:"target_id" swaggertype:"string" format:"uuid"`
+	RaceID    uuid.UUID       `json:"race_id" swaggertype:"string" format:"uuid"`
+	SubraceID *uuid.UUID      `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	BaseSpeed int             `json:"base_speed" example:"30"`
+	Speed     int             `json:"speed" example:"15"`
+	BaseSize  string          `json:"base_size" example:"Medium"`
+	Size      string          `json:"size" example:"Medium"`
+	Mechanics Mechanics       `json:"mechanics"`
+	Effects   []*ActiveEffect `json:"effects"`
+}
+
+// Rounds returns the duration in rounds, or nil when it lasts until removed.
+func (d Duration) Rounds() *int {
+	var rounds int
+	switch d.Unit {
+	case DurationRounds:
+		rounds = d.Amount
+	case DurationMinutes:
+		rounds = d.Amount * RoundsPerMinute
+	case DurationHours:
+		rounds = d.Amount * RoundsPerHour
+	default:
+		return nil
+	}
+	return &rounds
+}
+
+// TotalRounds returns the game time to advance in rounds.
+func (r AdvanceTimeRequest) TotalRounds() int {
+	return r.Rounds + r.Minutes*RoundsPerMinute + r.Hours*RoundsPerHour
+}
+
+// EffectiveMechanics returns the mechanics of the effect's condition at its level combined with its own. The condition
+// must be loaded.
+func (e *ActiveEffect) EffectiveMechanics() Mechanics {
+	if e.Condition == nil {
+		return e.Mechanics
+	}
+	return e.Condition.MechanicsAt(e.Level).Combine(e.Mechanics)
+}
+
+// CombinedMechanics returns the mechanics of every effect applying at once.
+func CombinedMechanics(effects []*ActiveEffect) Mechanics {
+	var mechanics Mechanics
+	for _, effect := range effects {
+		mechanics = mechanics.Combine(effect.EffectiveMechanics())
+	}
+	return mechanics
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// How a condition affects a kind of d20 roll. RollNormal means advantage and disadvantage both apply and cancel out.
+const (
+	RollAdvantage    = "advantage"
+	RollDisadvantage = "disadvantage"
+	RollNormal       = "normal"
+)
+
+// How a condition limits speed. SpeedZero wins over SpeedHalved.
+const (
+	SpeedHalved = "halved"
+	SpeedZero   = "zero"
+)
+
+// Sizes lists the size categories from smallest to largest.
+var Sizes = []string{"Tiny", "Small", "Medium", "Large", "Huge", "Gargantuan"}
+
+// Condition is a status effect of the catalog, such as blinded or grappled. Levels holds the extra mechanics of each
+// level of a leveled condition such as exhaustion; they are cumulative, so level 3 applies levels 1 to 3.
+type Condition struct {
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string      `json:"name" gorm:"unique;not null" example:"Restrained"`
+	Description string      `json:"description,omitempty"`
+	Mechanics   Mechanics   `json:"mechanics" gorm:"type:jsonb;serializer:json"`
+	Levels      []Mechanics `json:"levels,omitempty" gorm:"type:jsonb;serializer:json"`
+}
+
+// Mechanics are the structured game effects of a condition or an active effect. SpeedModifier is in feet and applies
+// before Speed halves or zeroes the result. SizeChange moves the size category up or down by that many steps.
+type Mechanics struct {
+	Speed                 string   `json:"speed,omitempty" enums:"halved,zero" example:"zero"`
+	SpeedModifier         int      `json:"speed_modifier,omitempty" example:"-10"`
+	SizeChange            int      `json:"size_change,omitempty" example:"1"`
+	Incapacitated         bool     `json:"incapacitated,omitempty"`
+	AttackRolls           string   `json:"attack_rolls,omitempty" enums:"advantage,disadvantage,normal" example:"disadvantage"`
+	AttacksAgainst        string   `json:"attacks_against,omitempty" enums:"advantage,disadvantage,normal" example:"advantage"`
+	AbilityChecks         string   `json:"ability_checks,omitempty" enums:"advantage,disadvantage,normal" example:"disadvantage"`
+	SaveDisadvantage      []string `json:"save_disadvantage,omitempty" example:"dexterity"`
+	AutoFailSaves         []string `json:"auto_fail_saves,omitempty" example:"strength"`
+	CriticalWithin5Feet   bool     `json:"critical_within_5_feet,omitempty"`
+	ResistanceToAllDamage bool     `json:"resistance_to_all_damage,omitempty"`
+	HalvedHitPointMaximum bool     `json:"halved_hit_point_maximum,omitempty"`
+	Dead                  bool     `json:"dead,omitempty"`
+}
+
+// MaxLevel returns the highest level of a leveled condition, or 0 when the condition has no levels.
+func (c *Condition) MaxLevel() int {
+	return len(c.Levels)
+}
+
+// MechanicsAt returns the mechanics of the condition at the given level, combining its base mechanics with those of
+// every level up to it.
+func (c *Condition) MechanicsAt(level int) Mechanics {
+	mechanics := c.Mechanics
+	for _, levelMechanics := range c.Levels[:min(max(level, 0), len(c.Levels))] {
+		mechanics = mechanics.Combine(levelMechanics)
+	}
+	return mechanics
+}
+
+// Combine returns the mechanics of both m and other applying at once. The strongest speed limit wins, modifiers add
+// up, advantage and disadvantage on the same roll cancel out and every flag or ability of either is kept.
+func (m Mechanics) Combine(other Mechanics) Mechanics {
+	return Mechanics{
+		Speed:                 combineSpeed(m.Speed, other.Speed),
+		SpeedModifier:         m.SpeedModifier + other.SpeedModifier,
+		SizeChange:            m.SizeChange + other.SizeChange,
+		Incapacitated:         m.Incapacitated || other.Incapacitated,
+		AttackRolls:           combineRoll(m.AttackRolls, other.AttackRolls),
+		AttacksAgainst:        combineRoll(m.AttacksAgainst, other.AttacksAgainst),
+		AbilityChecks:         combineRoll(m.AbilityChecks, other.AbilityChecks),
+		SaveDisadvantage:      combineAbilities(m.SaveDisadvantage, other.SaveDisadvantage),
+		AutoFailSaves:         combineAbilities(m.AutoFailSaves, other.AutoFailSaves),
+		CriticalWithin5Feet:   m.CriticalWithin5Feet || other.CriticalWithin5Feet,
+		ResistanceToAllDamage: m.ResistanceToAllDamage || other.ResistanceToAllDamage,
+		HalvedHitPointMaximum: m.HalvedHitPointMaximum || other.HalvedHitPointMaximum,
+		Dead:                  m.Dead || other.Dead,
+	}
+}
+
+// ApplySpeed returns the given speed in feet after the mechanics, never below 0.
+func (m Mechanics) ApplySpeed(speed int) int {
+	speed = max(0, speed+m.SpeedModifier)
+	switch m.Speed {
+	case SpeedZero:
+		return 0
+	case SpeedHalved:
+		return speed / 2
+	}
+	return speed
+}
+
+// ApplySize returns the given size category after the mechanics, kept within Tiny and Gargantuan. Unknown sizes are
+// returned unchanged.
+func (m Mechanics) ApplySize(size string) string {
+	index := slices.Index(Sizes, size)
+	if index < 0 || m.SizeChange == 0 {
+		return size
+	}
+	return Sizes[min(max(index+m.SizeChange, 0), len(Sizes)-1)]
+}
+
+// EndsConcentration reports whether a creature under the mechanics can no longer concentrate.
+func (m Mechanics) EndsConcentration() bool {
+	return m.Incapacitated || m.Dead
+}
+
+func combineSpeed(a, b string) string {
+	if a == SpeedZero || b == SpeedZero {
+		return SpeedZero
+	}
+	if a == SpeedHalved || b == SpeedHalved {
+		return SpeedHalved
+	}
+	return ""
+}
+
+func combineRoll(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "", a == b:
+		return a
+	}
+	return RollNormal
+}
+
+func combineAbilities(a, b []string) []string {
+	combined := slices.Clone(a)
+	for _, ability := range b {
+		if !slices.Contains(combined, ability) {
+			combined = append(combined, ability)
+		}
+	}
+	return combined
+}
@@ -0,0 +1,118 @@
+package models
+
+var strengthAndDexterity = []string{"strength", "dexterity"}
+
+// StandardConditions returns the conditions of the System Reference Document.
+func StandardConditions() []Condition {
+	return []Condition{
+		{
+			Name:        "Blinded",
+			Description: "A blinded creature can't see and automatically fails any ability check that requires sight. Attack rolls against the creature have advantage, and the creature's attack rolls have disadvantage.",
+			Mechanics:   Mechanics{AttackRolls: RollDisadvantage, AttacksAgainst: RollAdvantage},
+		},
+		{
+			Name:        "Charmed",
+			Description: "A charmed creature can't attack the charmer or target the charmer with harmful abilities or magical effects. The charmer has advantage on any ability check to interact socially with the creature.",
+		},
+		{
+			Name:        "Deafened",
+			Description: "A deafened creature can't hear and automatically fails any ability check that requires hearing.",
+		},
+		{
+			Name:        "Exhaustion",
+			Description: "Exhaustion is measured in six levels. Its effects are cumulative, and finishing a long rest reduces the level by 1.",
+			Levels: []Mechanics{
+				{AbilityChecks: RollDisadvantage},
+				{Speed: SpeedHalved},
+				{AttackRolls: RollDisadvantage, SaveDisadvantage: []string{"strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma"}},
+				{HalvedHitPointMaximum: true},
+				{Speed: SpeedZero},
+				{Dead: true},
+			},
+		},
+		{
+			Name:        "Frightened",
+			Description: "A frightened creature has disadvantage on ability checks and attack rolls while the source of its fear is within line of sight, and can't willingly move closer to the source of its fear.",
+			Mechanics:   Mechanics{AttackRolls: RollDisadvantage, AbilityChecks: RollDisadvantage},
+		},
+		{
+			Name:        "Grappled",
+			Description: "A grappled creature's speed becomes 0. The condition ends if the grappler is incapacitated or the creature is moved out of its reach.",
+			Mechanics:   Mechanics{Speed: SpeedZero},
+		},
+		{
+			Name:        "Incapacitated",
+			Description: "An incapacitated creature can't take actions or reactions.",
+			Mechanics:   Mechanics{Incapacitated: true},
+		},
+		{
+			Name:        "Invisible",
+			Description: "An invisible creature is impossible to see without the aid of magic or a special sense. Attack rolls against the creature have disadvantage, and the creature's attack rolls have advantage.",
+			Mechanics:   Mechanics{AttackRolls: RollAdvantage, AttacksAgainst: RollDisadvantage},
+		},
+		{
+			Name:        "Paralyzed",
+			Description: "A paralyzed creature is incapacitated and can't move or speak. It automatically fails Strength and Dexterity saving throws, attack rolls against it have advantage and any hit from within 5 feet is a critical hit.",
+			Mechanics: Mechanics{
+				Speed:               SpeedZero,
+				Incapacitated:       true,
+				AttacksAgainst:      RollAdvantage,
+				AutoFailSaves:       strengthAndDexterity,
+				CriticalWithin5Feet: true,
+			},
+		},
+		{
+			Name:        "Petrified",
+			Description: "A petrified creature is transformed into a solid inanimate substance. It is incapacitated, can't move or speak, automatically fails Strength and Dexterity saving throws, has resistance to all damage and attack rolls against it have advantage.",
+			Mechanics: Mechanics{
+				Speed:                 SpeedZero,
+				Incapacitated:         true,
+				AttacksAgainst:        RollAdvantage,
+				AutoFailSaves:         strengthAndDexterity,
+				ResistanceToAllDamage: true,
+			},
+		},
+		{
+			Name:        "Poisoned",
+			Description: "A poisoned creature has disadvantage on attack rolls and ability checks.",
+			Mechanics:   Mechanics{AttackRolls: RollDisadvantage, AbilityChecks: RollDisadvantage},
+		},
+		{
+			Name:        "Prone",
+			Description: "A prone creature's only movement option is to crawl. It has disadvantage on attack rolls. An attack roll against it has advantage if the attacker is within 5 feet, otherwise it has disadvantage.",
+			Mechanics:   Mechanics{AttackRolls: RollDisadvantage},
+		},
+		{
+			Name:        "Restrained",
+			Description: "A restrained creature's speed becomes 0. Attack rolls against it have advantage, its attack rolls have disadvantage and it has disadvantage on Dexterity saving throws.",
+			Mechanics: Mechanics{
+				Speed:            SpeedZero,
+				AttackRolls:      RollDisadvantage,
+				AttacksAgainst:   RollAdvantage,
+				SaveDisadvantage: []string{"dexterity"},
+			},
+		},
+		{
+			Name:        "Stunned",
+			Description: "A stunned creature is incapacitated, can't move and can speak only falteringly. It automatically fails Strength and Dexterity saving throws and attack rolls against it have advantage.",
+			Mechanics: Mechanics{
+				Speed:          SpeedZero,
+				Incapacitated:  true,
+				AttacksAgainst: RollAdvantage,
+				AutoFailSaves:  strengthAndDexterity,
+			},
+		},
+		{
+			Name:        "Unconscious",
+			Description: "An unconscious creature is incapacitated, can't move or speak, is unaware of its surroundings and falls prone. It automatically fails Strength and Dexterity saving throws, attack rolls against it have advantage and any hit from within 5 feet is a critical hit.",
+			Mechanics: Mechanics{
+				Speed:               SpeedZero,
+				Incapacitated:       true,
+				AttackRolls:         RollDisadvantage,
+				AttacksAgainst:      RollAdvantage,
+				AutoFailSaves:       strengthAndDexterity,
+				CriticalWithin5Feet: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/google/uuid"
+)
+
+type ActiveEffectRepository interface {
+	GetAllEffects(criteria map[string]string) ([]*models.ActiveEffect, error)
+	GetEffectByID(id uuid.UUID) (*models.ActiveEffect, error)
+	GetEffectsByTargetID(targetID uuid.UUID) ([]*models.ActiveEffect, error)
+	ApplyEffects(effects []*models.ActiveEffect, endConcentrationOf []uuid.UUID) ([]*models.ActiveEffect, error)
+	AdvanceTime(targetIDs []uuid.UUID, rounds int) ([]*models.ActiveEffect, error)
+	EndConcentration(sourceID uuid.UUID) ([]*models.ActiveEffect, error)
+	DeleteEffect(id uuid.UUID) error
+}
@@ -0,0 +1,177 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// activeEffectRepositoryGormImpl is a concrete implementation of the ActiveEffectRepository interface using GORM.
+type activeEffectRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormActiveEffectRepository creates a new instance of activeEffectRepositoryGormImpl.
+func NewGormActiveEffectRepository(db *gorm.DB) ActiveEffectRepository {
+	return &activeEffectRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllEffects retrieves the active effects matching the given criteria with their conditions, oldest first.
+// Criteria values are expected to be validated by the caller.
+func (r *activeEffectRepositoryGormImpl) GetAllEffects(criteria map[string]string) ([]*models.ActiveEffect, error) {
+	var effects []*models.ActiveEffect
+	query := r.db.Preload("Condition").Order("created_at")
+
+	for key, value := range criteria {
+		switch key {
+		case "target_id":
+			query = query.Where("target_id = ?", value)
+		case "source_id":
+			query = query.Where("source_id = ?", value)
+		case "condition_id":
+			query = query.Where("condition_id = ?", value)
+		case "concentration":
+			query = query.Where("concentration = ?", value == "true")
+		default:
+			return nil, fmt.Errorf("unknown active effect filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&effects).Error; err != nil {
+		return nil, err
+	}
+	return effects, nil
+}
+
+// GetEffectByID retrieves an active effect by its ID with its condition.
+func (r *activeEffectRepositoryGormImpl) GetEffectByID(id uuid.UUID) (*models.ActiveEffect, error) {
+	var effect models.ActiveEffect
+	if err := r.db.Preload("Condition").First(&effect, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("active effect with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &effect, nil
+}
+
+// GetEffectsByTargetID retrieves the active effects of a target with their conditions, oldest first.
+func (r *activeEffectRepositoryGormImpl) GetEffectsByTargetID(targetID uuid.UUID) ([]*models.ActiveEffect, error) {
+	var effects []*models.ActiveEffect
+	if err := r.db.Preload("Condition").Where("target_id = ?", targetID).Order("created_at").Find(&effects).Error; err != nil {
+		return nil, err
+	}
+	return effects, nil
+}
+
+// ApplyEffects stores the given effects in a single transaction. First the concentration effects of every source in
+// endConcentrationOf end, then each effect replaces any effect of the same name its target already has. The ended
+// and replaced effects are returned.
+func (r *activeEffectRepositoryGormImpl) ApplyEffects(effects []*models.ActiveEffect, endConcentrationOf []uuid.UUID) ([]*models.ActiveEffect, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	ended := []*models.ActiveEffect{}
+	if len(endConcentrationOf) > 0 {
+		var lost []*models.ActiveEffect
+		if err := tx.Clauses(clause.Returning{}).Where("concentration AND source_id IN ?", endConcentrationOf).Delete(&lost).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		ended = append(ended, lost...)
+	}
+
+	for _, effect := range effects {
+		var replaced []*models.ActiveEffect
+		if err := tx.Clauses(clause.Returning{}).Where("target_id = ? AND lower(name) = lower(?)", effect.TargetID, effect.Name).Delete(&replaced).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		ended = append(ended, replaced...)
+	}
+
+	if err := tx.Omit("Condition").Create(&effects).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return ended, nil
+}
+
+// AdvanceTime counts rounds down on the timed effects of the given targets, or of every target when none is given,
+// and removes the effects that expire in the same transaction. The expired effects are returned.
+func (r *activeEffectRepositoryGormImpl) AdvanceTime(targetIDs []uuid.UUID, rounds int) ([]*models.ActiveEffect, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	scope := func(query *gorm.DB) *gorm.DB {
+		query = query.Where("remaining_rounds IS NOT NULL")
+		if len(targetIDs) > 0 {
+			query = query.Where("target_id IN ?", targetIDs)
+		}
+		return query
+	}
+
+	if err := tx.Model(&models.ActiveEffect{}).Scopes(scope).Update("remaining_rounds", gorm.Expr("remaining_rounds - ?", rounds)).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	expired := []*models.ActiveEffect{}
+	if err := tx.Clauses(clause.Returning{}).Scopes(scope).Where("remaining_rounds <= 0").Delete(&expired).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// EndConcentration removes the concentration effects of a source and returns them.
+func (r *activeEffectRepositoryGormImpl) EndConcentration(sourceID uuid.UUID) ([]*models.ActiveEffect, error) {
+	ended := []*models.ActiveEffect{}
+	if err := r.db.Clauses(clause.Returning{}).Where("concentration AND source_id = ?", sourceID).Delete(&ended).Error; err != nil {
+		return nil, err
+	}
+	return ended, nil
+}
+
+// DeleteEffect removes an active effect from the database.
+func (r *activeEffectRepositoryGormImpl) DeleteEffect(id uuid.UUID) error {
+	result := r.db.Delete(&models.ActiveEffect{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("active effect with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
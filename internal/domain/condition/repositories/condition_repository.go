@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/google/uuid"
+)
+
+type ConditionRepository interface {
+	GetAllConditions(criteria map[string]string) ([]*models.Condition, error)
+	GetConditionByID(id uuid.UUID) (*models.Condition, error)
+	GetConditionByName(name string) (*models.Condition, error)
+	CreateCondition(condition *models.Condition) error
+	UpdateCondition(id uuid.UUID, condition *models.Condition) error
+	DeleteCondition(id uuid.UUID) error
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// conditionRepositoryGormImpl is a concrete implementation of the ConditionRepository interface using GORM.
+type conditionRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormConditionRepository creates a new instance of conditionRepositoryGormImpl.
+func NewGormConditionRepository(db *gorm.DB) ConditionRepository {
+	return &conditionRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllConditions retrieves the conditions matching the given criteria ordered by name. Criteria values are
+// expected to be validated by the caller.
+func (r *conditionRepositoryGormImpl) GetAllConditions(criteria map[string]string) ([]*models.Condition, error) {
+	var conditions []*models.Condition
+	query := r.db.Order("name")
+
+	for key, value := range criteria {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", "%"+value+"%")
+		case "leveled":
+			if value == "true" {
+				query = query.Where("jsonb_array_length(COALESCE(levels, '[]'::jsonb)) > 0")
+			} else {
+				query = query.Where("jsonb_array_length(COALESCE(levels, '[]'::jsonb)) = 0")
+			}
+		case "incapacitating":
+			if value == "true" {
+				query = query.Where("mechanics @> ?::jsonb", `{"incapacitated": true}`)
+			} else {
+				query = query.Not("mechanics @> ?::jsonb", `{"incapacitated": true}`)
+			}
+		default:
+			return nil, fmt.Errorf("unknown condition filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&conditions).Error; err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// GetConditionByID retrieves a condition by its ID.
+func (r *conditionRepositoryGormImpl) GetConditionByID(id uuid.UUID) (*models.Condition, error) {
+	var condition models.Condition
+	if err := r.db.First(&condition, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("condition with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &condition, nil
+}
+
+// GetConditionByName retrieves a condition by its name, ignoring case.
+func (r *conditionRepositoryGormImpl) GetConditionByName(name string) (*models.Condition, error) {
+	var condition models.Condition
+	if err := r.db.Where("lower(name) = lower(?)", name).First(&condition).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("condition with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &condition, nil
+}
+
+// CreateCondition adds a new condition to the database.
+func (r *conditionRepositoryGormImpl) CreateCondition(condition *models.Condition) error {
+	return r.db.Create(condition).Error
+}
+
+// UpdateCondition replaces an existing condition's details in the database.
+func (r *conditionRepositoryGormImpl) UpdateCondition(id uuid.UUID, condition *models.Condition) error {
+	var existingCondition models.Condition
+	if err := r.db.First(&existingCondition, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("condition with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	condition.ID = existingCondition.ID
+	return r.db.Save(condition).Error
+}
+
+// DeleteCondition removes a condition from the database. Active effects of the condition are removed with it.
+func (r *conditionRepositoryGormImpl) DeleteCondition(id uuid.UUID) error {
+	result := r.db.Delete(&models.Condition{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("condition with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
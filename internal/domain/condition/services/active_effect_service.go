@@ -0,0 +1,16 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/google/uuid"
+)
+
+type ActiveEffectService interface {
+	ListEffects(criteria map[string]string) ([]*models.ActiveEffect, error)
+	GetEffect(id uuid.UUID) (*models.ActiveEffect, error)
+	ApplyEffect(request *models.ApplyEffectRequest) (*models.ApplyEffectResult, error)
+	RemoveEffect(id uuid.UUID) error
+	EndConcentration(sourceID uuid.UUID) ([]*models.ActiveEffect, error)
+	AdvanceTime(request *models.AdvanceTimeRequest) (*models.AdvanceTimeResult, error)
+	GetEffectiveStats(targetID uuid.UUID, raceID uuid.UUID, subraceID *uuid.UUID) (*models.EffectiveStats, error)
+}
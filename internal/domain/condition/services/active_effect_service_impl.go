@@ -0,0 +1,267 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/repositories"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// activeEffectServiceImpl is the concrete implementation of ActiveEffectService.
+type activeEffectServiceImpl struct {
+	repo             repositories.ActiveEffectRepository
+	conditionService ConditionService
+	raceService      raceServices.RaceService
+}
+
+// NewActiveEffectService creates a new instance of activeEffectServiceImpl.
+func NewActiveEffectService(repo repositories.ActiveEffectRepository, conditionService ConditionService, raceService raceServices.RaceService) ActiveEffectService {
+	return &activeEffectServiceImpl{
+		repo:             repo,
+		conditionService: conditionService,
+		raceService:      raceService,
+	}
+}
+
+func (s *activeEffectServiceImpl) ListEffects(criteria map[string]string) ([]*models.ActiveEffect, error) {
+	if err := validateEffectCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect filter: %w", err))
+	}
+
+	effects, err := s.repo.GetAllEffects(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list active effects: %w", err))
+	}
+	return effects, nil
+}
+
+func (s *activeEffectServiceImpl) GetEffect(id uuid.UUID) (*models.ActiveEffect, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect ID: %s", id.String()))
+	}
+
+	effect, err := s.repo.GetEffectByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get active effect by ID: %w", err))
+	}
+	return effect, nil
+}
+
+func (s *activeEffectServiceImpl) ApplyEffect(request *models.ApplyEffectRequest) (*models.ApplyEffectResult, error) {
+	if err := validateApplyRequest(request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect data: %w", err))
+	}
+
+	var condition *models.Condition
+	if request.ConditionID != nil {
+		var err error
+		condition, err = s.conditionService.GetConditionDetails(*request.ConditionID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		if err := applyCondition(request, condition); err != nil {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect data: %w", err))
+		}
+	} else if request.Level != 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("only leveled conditions have a level"))
+	}
+
+	effects := make([]*models.ActiveEffect, 0, len(request.TargetIDs))
+	for _, targetID := range request.TargetIDs {
+		effects = append(effects, &models.ActiveEffect{
+			TargetID:        targetID,
+			ConditionID:     request.ConditionID,
+			Condition:       condition,
+			Name:            request.Name,
+			Level:           request.Level,
+			Mechanics:       request.Mechanics,
+			Duration:        request.Duration,
+			RemainingRounds: request.Duration.Rounds(),
+			SourceID:        request.SourceID,
+			Concentration:   request.Concentration,
+		})
+	}
+
+	// Concentrating on a new effect ends the previous one, and an incapacitated target can no longer concentrate.
+	endConcentrationOf := []uuid.UUID{}
+	if request.Concentration {
+		endConcentrationOf = append(endConcentrationOf, *request.SourceID)
+	}
+	if effects[0].EffectiveMechanics().EndsConcentration() {
+		endConcentrationOf = append(endConcentrationOf, request.TargetIDs...)
+	}
+
+	ended, err := s.repo.ApplyEffects(effects, endConcentrationOf)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to apply active effect: %w", err))
+	}
+	return &models.ApplyEffectResult{Applied: effects, Ended: ended}, nil
+}
+
+func (s *activeEffectServiceImpl) RemoveEffect(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid active effect ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteEffect(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove active effect: %w", err))
+	}
+	return nil
+}
+
+func (s *activeEffectServiceImpl) EndConcentration(sourceID uuid.UUID) ([]*models.ActiveEffect, error) {
+	if sourceID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid source ID: %s", sourceID.String()))
+	}
+
+	ended, err := s.repo.EndConcentration(sourceID)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to end concentration: %w", err))
+	}
+	return ended, nil
+}
+
+func (s *activeEffectServiceImpl) AdvanceTime(request *models.AdvanceTimeRequest) (*models.AdvanceTimeResult, error) {
+	if request.Rounds < 0 || request.Minutes < 0 || request.Hours < 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("time cannot go backwards"))
+	}
+	if request.Rounds > models.MaxDurationRounds || request.Minutes > models.MaxDurationRounds || request.Hours > models.MaxDurationRounds {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("time can advance by at most %d rounds, a year of game time", models.MaxDurationRounds))
+	}
+	rounds := request.TotalRounds()
+	if rounds > models.MaxDurationRounds {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("time can advance by at most %d rounds, a year of game time: %d", models.MaxDurationRounds, rounds))
+	}
+	if rounds == 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("advance time by at least one round"))
+	}
+	if slices.Contains(request.TargetIDs, uuid.Nil) {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("target IDs cannot be empty"))
+	}
+
+	expired, err := s.repo.AdvanceTime(request.TargetIDs, rounds)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to advance time: %w", err))
+	}
+	return &models.AdvanceTimeResult{Rounds: rounds, Expired: expired}, nil
+}
+
+func (s *activeEffectServiceImpl) GetEffectiveStats(targetID uuid.UUID, raceID uuid.UUID, subraceID *uuid.UUID) (*models.EffectiveStats, error) {
+	if targetID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid target ID: %s", targetID.String()))
+	}
+
+	stats := &models.EffectiveStats{TargetID: targetID, RaceID: raceID, SubraceID: subraceID}
+	if subraceID == nil {
+		race, err := s.raceService.GetRaceDetails(raceID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		stats.BaseSpeed, stats.BaseSize = int(race.Speed), race.Size
+	} else {
+		effective, err := s.raceService.ResolveEffectiveRace(raceID, *subraceID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		stats.BaseSpeed, stats.BaseSize = int(effective.Speed.Value), effective.Size.Value
+	}
+
+	effects, err := s.repo.GetEffectsByTargetID(targetID)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get active effects of target: %w", err))
+	}
+
+	stats.Effects = effects
+	stats.Mechanics = models.CombinedMechanics(effects)
+	stats.Speed = stats.Mechanics.ApplySpeed(stats.BaseSpeed)
+	stats.Size = stats.Mechanics.ApplySize(stats.BaseSize)
+	return stats, nil
+}
+
+// applyCondition names the effect after its condition and checks its level. Leveled conditions start at level 1.
+func applyCondition(request *models.ApplyEffectRequest, condition *models.Condition) error {
+	request.Name = condition.Name
+	if condition.MaxLevel() == 0 {
+		if request.Level != 0 {
+			return fmt.Errorf("%s has no levels", condition.Name)
+		}
+		return nil
+	}
+
+	if request.Level == 0 {
+		request.Level = 1
+	}
+	if request.Level < 1 || request.Level > condition.MaxLevel() {
+		return fmt.Errorf("%s level must be between 1 and %d: %d", condition.Name, condition.MaxLevel(), request.Level)
+	}
+	return nil
+}
+
+func validateApplyRequest(request *models.ApplyEffectRequest) error {
+	if len(request.TargetIDs) == 0 {
+		return errors.New("at least one target is required")
+	}
+	seen := make(map[uuid.UUID]bool)
+	for _, targetID := range request.TargetIDs {
+		if targetID == uuid.Nil {
+			return errors.New("target IDs cannot be empty")
+		}
+		if seen[targetID] {
+			return fmt.Errorf("target %s is listed more than once", targetID.String())
+		}
+		seen[targetID] = true
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if request.ConditionID == nil && request.Name == "" {
+		return errors.New("a custom effect needs a name")
+	}
+	if err := validateMechanics(&request.Mechanics); err != nil {
+		return err
+	}
+
+	if !slices.Contains(models.DurationUnits, request.Duration.Unit) {
+		return fmt.Errorf("invalid duration unit: %s", request.Duration.Unit)
+	}
+	if request.Duration.Unit == models.DurationUntilRemoved {
+		if request.Duration.Amount != 0 {
+			return errors.New("effects lasting until removed have no duration amount")
+		}
+	} else if request.Duration.Amount <= 0 {
+		return fmt.Errorf("duration amount must be positive: %d", request.Duration.Amount)
+	} else if request.Duration.Amount > models.MaxDurationRounds || *request.Duration.Rounds() > models.MaxDurationRounds {
+		return fmt.Errorf("duration cannot exceed %d rounds, a year of game time; use until_removed for longer effects", models.MaxDurationRounds)
+	}
+
+	if request.SourceID != nil && *request.SourceID == uuid.Nil {
+		return errors.New("source ID cannot be empty")
+	}
+	if request.Concentration && request.SourceID == nil {
+		return errors.New("concentration effects need the source concentrating on them")
+	}
+	return nil
+}
+
+func validateEffectCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "target_id", "source_id", "condition_id":
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("%s must be a UUID: %s", key, value)
+			}
+		case "concentration":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("concentration must be true or false: %s", value)
+			}
+		default:
+			return fmt.Errorf("unknown filter '%s', allowed filters are target_id, source_id, condition_id and concentration", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/google/uuid"
+)
+
+type ConditionService interface {
+	ListConditions(criteria map[string]string) ([]*models.Condition, error)
+	GetConditionDetails(id uuid.UUID) (*models.Condition, error)
+	RegisterCondition(condition *models.Condition) error
+	UpdateConditionInfo(id uuid.UUID, condition *models.Condition) error
+	RemoveCondition(id uuid.UUID) error
+	InstallStandardConditions() ([]*models.Condition, error)
+}
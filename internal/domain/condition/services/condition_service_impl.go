@@ -0,0 +1,191 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/condition/repositories"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const maxConditionLevels = 10
+
+// conditionServiceImpl is the concrete implementation of ConditionService.
+type conditionServiceImpl struct {
+	repo repositories.ConditionRepository
+}
+
+// NewConditionService creates a new instance of conditionServiceImpl.
+func NewConditionService(repo repositories.ConditionRepository) ConditionService {
+	return &conditionServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *conditionServiceImpl) ListConditions(criteria map[string]string) ([]*models.Condition, error) {
+	if err := validateConditionCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition filter: %w", err))
+	}
+
+	conditions, err := s.repo.GetAllConditions(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list conditions: %w", err))
+	}
+	return conditions, nil
+}
+
+func (s *conditionServiceImpl) GetConditionDetails(id uuid.UUID) (*models.Condition, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition ID: %s", id.String()))
+	}
+
+	condition, err := s.repo.GetConditionByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get condition details by ID: %w", err))
+	}
+	return condition, nil
+}
+
+func (s *conditionServiceImpl) RegisterCondition(condition *models.Condition) error {
+	if err := validateCondition(condition); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition data: %w", err))
+	}
+
+	existingCondition, _ := s.repo.GetConditionByName(condition.Name)
+	if existingCondition != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("condition with name '%s' already exists", condition.Name))
+	}
+
+	if err := s.repo.CreateCondition(condition); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register condition: %w", err))
+	}
+	return nil
+}
+
+func (s *conditionServiceImpl) UpdateConditionInfo(id uuid.UUID, condition *models.Condition) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition ID: %s", id.String()))
+	}
+
+	if err := validateCondition(condition); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition data: %w", err))
+	}
+
+	duplicateCondition, _ := s.repo.GetConditionByName(condition.Name)
+	if duplicateCondition != nil && duplicateCondition.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("condition with name '%s' already exists", condition.Name))
+	}
+
+	if err := s.repo.UpdateCondition(id, condition); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update condition info: %w", err))
+	}
+	return nil
+}
+
+func (s *conditionServiceImpl) RemoveCondition(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid condition ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteCondition(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove condition: %w", err))
+	}
+	return nil
+}
+
+func (s *conditionServiceImpl) InstallStandardConditions() ([]*models.Condition, error) {
+	installed := []*models.Condition{}
+	for _, condition := range models.StandardConditions() {
+		existingCondition, _ := s.repo.GetConditionByName(condition.Name)
+		if existingCondition != nil {
+			continue
+		}
+
+		if err := s.repo.CreateCondition(&condition); err != nil {
+			return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to install condition '%s': %w", condition.Name, err))
+		}
+		installed = append(installed, &condition)
+	}
+	return installed, nil
+}
+
+func validateCondition(condition *models.Condition) error {
+	condition.Name = strings.TrimSpace(condition.Name)
+	if condition.Name == "" {
+		return errors.New("condition name cannot be empty")
+	}
+
+	if err := validateMechanics(&condition.Mechanics); err != nil {
+		return err
+	}
+
+	if len(condition.Levels) > maxConditionLevels {
+		return fmt.Errorf("a condition can have at most %d levels: %d", maxConditionLevels, len(condition.Levels))
+	}
+	for i := range condition.Levels {
+		if err := validateMechanics(&condition.Levels[i]); err != nil {
+			return fmt.Errorf("level %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func validateMechanics(mechanics *models.Mechanics) error {
+	if mechanics.Speed != "" && mechanics.Speed != models.SpeedHalved && mechanics.Speed != models.SpeedZero {
+		return fmt.Errorf("invalid speed effect: %s", mechanics.Speed)
+	}
+
+	maxSizeChange := len(models.Sizes) - 1
+	if mechanics.SizeChange < -maxSizeChange || mechanics.SizeChange > maxSizeChange {
+		return fmt.Errorf("size change must be between %d and %d: %d", -maxSizeChange, maxSizeChange, mechanics.SizeChange)
+	}
+
+	rolls := map[string]string{
+		"attack rolls":    mechanics.AttackRolls,
+		"attacks against": mechanics.AttacksAgainst,
+		"ability checks":  mechanics.AbilityChecks,
+	}
+	for roll, value := range rolls {
+		if value != "" && value != models.RollAdvantage && value != models.RollDisadvantage {
+			return fmt.Errorf("%s must have advantage or disadvantage: %s", roll, value)
+		}
+	}
+
+	saves := map[string][]string{
+		"save disadvantage": mechanics.SaveDisadvantage,
+		"auto-fail saves":   mechanics.AutoFailSaves,
+	}
+	for field, abilities := range saves {
+		seen := make(map[string]bool)
+		for _, ability := range abilities {
+			if !slices.Contains(raceModels.Abilities, ability) {
+				return fmt.Errorf("invalid ability in %s: %s", field, ability)
+			}
+			if seen[ability] {
+				return fmt.Errorf("duplicate ability in %s: %s", field, ability)
+			}
+			seen[ability] = true
+		}
+	}
+	return nil
+}
+
+func validateConditionCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "leveled", "incapacitating":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("%s must be true or false: %s", key, value)
+			}
+		case "name":
+		default:
+			return fmt.Errorf("unknown filter '%s', allowed filters are name, leveled and incapacitating", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type DiceController interface {
+	Roll(ctx *gin.Context)
+	Stats(ctx *gin.Context)
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/dice/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/dice/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// diceControllerGin is a concrete implementation of DiceController using the Gin framework.
+type diceControllerGin struct {
+	service services.DiceService
+}
+
+// NewDiceControllerGin creates a new instance of diceControllerGin.
+func NewDiceControllerGin(service services.DiceService) DiceController {
+	return &diceControllerGin{
+		service: service,
+	}
+}
+
+// Roll godoc
+// @Summary      Roll dice
+// @Description  Roll a dice expression such as 2d6+3, 4d6kh3, 1d20adv or 3d6! and return every die. The seed used is returned so the roll can be reproduced.
+// @Tags         Dice
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.DiceRollRequest  true  "Expression and optional seed"
+// @Success      200      {object}  models.DiceRoll
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Router       /dice/roll [post]
+func (c *diceControllerGin) Roll(ctx *gin.Context) {
+	var request models.DiceRollRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	roll, err := c.service.Roll(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, roll)
+}
+
+// Stats godoc
+// @Summary      Dice statistics
+// @Description  Compute the minimum, maximum, average and exact probability distribution of a dice expression
+// @Tags         Dice
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.DiceStatsRequest  true  "Expression"
+// @Success      200      {object}  dice.Stats
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Router       /dice/stats [post]
+func (c *diceControllerGin) Stats(ctx *gin.Context) {
+	var request models.DiceStatsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	stats, err := c.service.Stats(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, stats)
+}
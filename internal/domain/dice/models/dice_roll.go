@@ -0,0 +1,20 @@
+package models
+
+import "github.com/Casagrande-Lucas/dnd/pkg/dice"
+
+// DiceRollRequest asks to roll an expression, optionally with a seed to reproduce an earlier roll.
+type DiceRollRequest struct {
+	Expression string  `json:"expression" binding:"required" example:"4d6kh3"`
+	Seed       *uint64 `json:"seed,omitempty"`
+}
+
+// DiceStatsRequest asks for the distribution of an expression.
+type DiceStatsRequest struct {
+	Expression string `json:"expression" binding:"required" example:"2d6+3"`
+}
+
+// DiceRoll is a rolled expression together with the seed that reproduces it.
+type DiceRoll struct {
+	dice.Result
+	Seed uint64 `json:"seed"`
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/dice/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+)
+
+type DiceService interface {
+	Roll(request *models.DiceRollRequest) (*models.DiceRoll, error)
+	Stats(request *models.DiceStatsRequest) (*dice.Stats, error)
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/dice/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+)
+
+// diceServiceImpl is the concrete implementation of DiceService.
+type diceServiceImpl struct{}
+
+// NewDiceService creates a new instance of diceServiceImpl.
+func NewDiceService() DiceService {
+	return &diceServiceImpl{}
+}
+
+func (s *diceServiceImpl) Roll(request *models.DiceRollRequest) (*models.DiceRoll, error) {
+	expr, err := dice.Parse(request.Expression)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	seed := rand.Uint64()
+	if request.Seed != nil {
+		seed = *request.Seed
+	}
+
+	return &models.DiceRoll{
+		Result: *dice.NewSeededRoller(seed).Roll(expr),
+		Seed:   seed,
+	}, nil
+}
+
+func (s *diceServiceImpl) Stats(request *models.DiceStatsRequest) (*dice.Stats, error) {
+	expr, err := dice.Parse(request.Expression)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	stats, err := dice.Analyze(expr)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("failed to compute dice statistics: %w", err))
+	}
+	return stats, nil
+}
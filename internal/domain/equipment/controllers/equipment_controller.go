@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type EquipmentController interface {
+	GetAllEquipment(ctx *gin.Context)
+	GetEquipmentByID(ctx *gin.Context)
+	CreateEquipment(ctx *gin.Context)
+	UpdateEquipment(ctx *gin.Context)
+	DeleteEquipment(ctx *gin.Context)
+}
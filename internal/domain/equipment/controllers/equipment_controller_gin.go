@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// equipmentControllerGin is a concrete implementation of EquipmentController using the Gin framework.
+type equipmentControllerGin struct {
+	service services.EquipmentService
+}
+
+// NewEquipmentControllerGin creates a new instance of equipmentControllerGin.
+func NewEquipmentControllerGin(service services.EquipmentService) EquipmentController {
+	return &equipmentControllerGin{
+		service: service,
+	}
+}
+
+// GetAllEquipment godoc
+// @Summary      List all equipment
+// @Description  Return all registered items ordered by category and name, optionally filtered
+// @Tags         Equipment
+// @Accept       json
+// @Produce      json
+// @Param        name                  query     string   false  "Case-insensitive name fragment"
+// @Param        category              query     string   false  "Category (adventuring_gear, weapon, armor, tool, mount or trade_good)"
+// @Param        max_weight            query     number   false  "Maximum weight in pounds"
+// @Param        max_cost              query     integer  false  "Maximum cost in copper pieces"
+// @Param        weapon_kind           query     string   false  "Weapon kind (simple or martial)"
+// @Param        weapon_range          query     string   false  "Weapon range (melee or ranged)"
+// @Param        damage_type           query     string   false  "Weapon damage type"
+// @Param        property              query     string   false  "Weapon property, such as finesse or two_handed"
+// @Param        armor_type            query     string   false  "Armor type (light, medium, heavy or shield)"
+// @Param        stealth_disadvantage  query     bool     false  "Armor imposes disadvantage on stealth"
+// @Success      200                   {array}   models.Equipment
+// @Failure      400                   {object}  httperror.ErrorResponse
+// @Failure      500                   {object}  httperror.ErrorResponse
+// @Router       /equipment [get]
+func (c *equipmentControllerGin) GetAllEquipment(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	equipment, err := c.service.ListEquipment(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, equipment)
+}
+
+// GetEquipmentByID godoc
+// @Summary      Get equipment by ID
+// @Description  Retrieve an item using the provided ID
+// @Tags         Equipment
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Equipment ID (UUID)"
+// @Success      200  {object}  models.Equipment
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /equipment/{id} [get]
+func (c *equipmentControllerGin) GetEquipmentByID(ctx *gin.Context) {
+	id, err := parseEquipmentID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	equipment, err := c.service.GetEquipmentDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, equipment)
+}
+
+// CreateEquipment godoc
+// @Summary      Create equipment
+// @Description  Create a new item
+// @Tags         Equipment
+// @Accept       json
+// @Produce      json
+// @Param        equipment  body      models.Equipment  true  "Equipment info"
+// @Success      201        {object}  models.Equipment
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Failure      500        {object}  httperror.ErrorResponse
+// @Router       /equipment [post]
+func (c *equipmentControllerGin) CreateEquipment(ctx *gin.Context) {
+	var equipment models.Equipment
+	if err := ctx.ShouldBindJSON(&equipment); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterEquipment(&equipment); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, equipment)
+}
+
+// UpdateEquipment godoc
+// @Summary      Update equipment
+// @Description  Update an existing item
+// @Tags         Equipment
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string            true  "Equipment ID (UUID)"
+// @Param        equipment  body      models.Equipment  true  "Equipment info"
+// @Success      200        {object}  models.Equipment
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Router       /equipment/{id} [put]
+func (c *equipmentControllerGin) UpdateEquipment(ctx *gin.Context) {
+	id, err := parseEquipmentID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var equipment models.Equipment
+	if err := ctx.ShouldBindJSON(&equipment); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateEquipmentInfo(id, &equipment); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, equipment)
+}
+
+// DeleteEquipment godoc
+// @Summary      Delete equipment
+// @Description  Delete an existing item
+// @Tags         Equipment
+// @Param        id   path      string  true  "Equipment ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /equipment/{id} [delete]
+func (c *equipmentControllerGin) DeleteEquipment(ctx *gin.Context) {
+	id, err := parseEquipmentID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveEquipment(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseEquipmentID reads the equipment ID path parameter.
+func parseEquipmentID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment ID: %w", err))
+	}
+	return id, nil
+}
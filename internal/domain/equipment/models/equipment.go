@@ -0,0 +1,137 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CategoryAdventuringGear = "adventuring_gear"
+	CategoryWeapon          = "weapon"
+	CategoryArmor           = "armor"
+	CategoryTool            = "tool"
+	CategoryMount           = "mount"
+	CategoryTradeGood       = "trade_good"
+)
+
+const (
+	CurrencyCopper = "cp"
+	CurrencySilver = "sp"
+	CurrencyGold   = "gp"
+)
+
+const (
+	WeaponKindSimple  = "simple"
+	WeaponKindMartial = "martial"
+
+	WeaponRangeMelee  = "melee"
+	WeaponRangeRanged = "ranged"
+)
+
+const (
+	WeaponPropertyAmmunition = "ammunition"
+	WeaponPropertyFinesse    = "finesse"
+	WeaponPropertyHeavy      = "heavy"
+	WeaponPropertyLight      = "light"
+	WeaponPropertyLoading    = "loading"
+	WeaponPropertyReach      = "reach"
+	WeaponPropertySpecial    = "special"
+	WeaponPropertyThrown     = "thrown"
+	WeaponPropertyTwoHanded  = "two_handed"
+	WeaponPropertyVersatile  = "versatile"
+)
+
+const (
+	ArmorTypeLight  = "light"
+	ArmorTypeMedium = "medium"
+	ArmorTypeHeavy  = "heavy"
+	ArmorTypeShield = "shield"
+)
+
+// Categories, Currencies, WeaponProperties, DamageTypes and ArmorTypes list the accepted values of each field.
+var (
+	Categories       = []string{CategoryAdventuringGear, CategoryWeapon, CategoryArmor, CategoryTool, CategoryMount, CategoryTradeGood}
+	Currencies       = []string{CurrencyCopper, CurrencySilver, CurrencyGold}
+	WeaponProperties = []string{
+		WeaponPropertyAmmunition,
+		WeaponPropertyFinesse,
+		WeaponPropertyHeavy,
+		WeaponPropertyLight,
+		WeaponPropertyLoading,
+		WeaponPropertyReach,
+		WeaponPropertySpecial,
+		WeaponPropertyThrown,
+		WeaponPropertyTwoHanded,
+		WeaponPropertyVersatile,
+	}
+	DamageTypes = []string{
+		"acid", "bludgeoning", "cold", "fire", "force", "lightning", "necrotic",
+		"piercing", "poison", "psychic", "radiant", "slashing", "thunder",
+	}
+	ArmorTypes = []string{ArmorTypeLight, ArmorTypeMedium, ArmorTypeHeavy, ArmorTypeShield}
+)
+
+// copperPerUnit is the value of one coin of each currency in copper pieces.
+var copperPerUnit = map[string]int{CurrencyCopper: 1, CurrencySilver: 10, CurrencyGold: 100}
+
+type Equipment struct {
+	ID          uuid.UUID    `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string       `json:"name" gorm:"unique;not null" example:"Longsword"`
+	Category    string       `json:"category" gorm:"not null;index" enums:"adventuring_gear,weapon,armor,tool,mount,trade_good" example:"weapon"`
+	Description string       `json:"description,omitempty"`
+	Weight      float64      `json:"weight" example:"3"`
+	Cost        Cost         `json:"cost" gorm:"embedded;embeddedPrefix:cost_"`
+	Weapon      *WeaponStats `json:"weapon,omitempty" gorm:"type:jsonb;serializer:json"`
+	Armor       *ArmorStats  `json:"armor,omitempty" gorm:"type:jsonb;serializer:json"`
+}
+
+// Cost is a price in a single currency.
+type Cost struct {
+	Amount   int    `json:"amount" example:"15"`
+	Currency string `json:"currency" gorm:"default:gp" enums:"cp,sp,gp" example:"gp"`
+}
+
+// WeaponStats holds the attack data of a weapon. Ranges are in feet and only apply to thrown and ammunition weapons.
+type WeaponStats struct {
+	Kind            string   `json:"kind" enums:"simple,martial" example:"martial"`
+	Range           string   `json:"range" enums:"melee,ranged" example:"melee"`
+	Damage          string   `json:"damage" example:"1d8"`
+	DamageType      string   `json:"damage_type" example:"slashing"`
+	VersatileDamage string   `json:"versatile_damage,omitempty" example:"1d10"`
+	Properties      []string `json:"properties,omitempty" example:"versatile"`
+	NormalRange     int      `json:"normal_range,omitempty"`
+	LongRange       int      `json:"long_range,omitempty"`
+}
+
+// ArmorStats holds the defensive data of armor. A shield's BaseAC is the bonus it adds. MaxDexBonus is nil when
+// the full Dexterity modifier applies.
+type ArmorStats struct {
+	Type                string `json:"type" enums:"light,medium,heavy,shield" example:"medium"`
+	BaseAC              int    `json:"base_ac" example:"14"`
+	MaxDexBonus         *int   `json:"max_dex_bonus,omitempty" example:"2"`
+	StrengthRequirement int    `json:"strength_requirement,omitempty" example:"0"`
+	StealthDisadvantage bool   `json:"stealth_disadvantage"`
+}
+
+// InCopper returns the cost in copper pieces.
+func (c Cost) InCopper() int {
+	return c.Amount * copperPerUnit[c.Currency]
+}
+
+// HasProperty reports whether the weapon has the given property.
+func (w *WeaponStats) HasProperty(property string) bool {
+	return slices.Contains(w.Properties, property)
+}
+
+// ArmorClass returns the armor class granted by wearing the armor with the given Dexterity modifier.
+// For a shield it returns the bonus added on top of the wearer's armor class.
+func (a *ArmorStats) ArmorClass(dexModifier int) int {
+	if a.Type == ArmorTypeShield {
+		return a.BaseAC
+	}
+	if a.MaxDexBonus != nil {
+		dexModifier = min(dexModifier, *a.MaxDexBonus)
+	}
+	return a.BaseAC + dexModifier
+}
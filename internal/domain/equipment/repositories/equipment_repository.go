@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/google/uuid"
+)
+
+type EquipmentRepository interface {
+	GetAllEquipment(criteria map[string]string) ([]*models.Equipment, error)
+	GetEquipmentByID(id uuid.UUID) (*models.Equipment, error)
+	GetEquipmentByName(name string) (*models.Equipment, error)
+	CreateEquipment(equipment *models.Equipment) error
+	UpdateEquipment(id uuid.UUID, equipment *models.Equipment) error
+	DeleteEquipment(id uuid.UUID) error
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// costInCopper converts the stored cost to copper pieces so items priced in different currencies compare.
+const costInCopper = "cost_amount * CASE cost_currency WHEN 'gp' THEN 100 WHEN 'sp' THEN 10 ELSE 1 END"
+
+// equipmentRepositoryGormImpl is a concrete implementation of the EquipmentRepository interface using GORM.
+type equipmentRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormEquipmentRepository creates a new instance of equipmentRepositoryGormImpl.
+func NewGormEquipmentRepository(db *gorm.DB) EquipmentRepository {
+	return &equipmentRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllEquipment retrieves the items matching the given criteria ordered by category and name. Criteria values
+// are expected to be validated by the caller.
+func (r *equipmentRepositoryGormImpl) GetAllEquipment(criteria map[string]string) ([]*models.Equipment, error) {
+	var equipment []*models.Equipment
+	query := r.db.Order("category").Order("name")
+
+	for key, value := range criteria {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", "%"+value+"%")
+		case "category":
+			query = query.Where("category = ?", value)
+		case "max_weight":
+			query = query.Where("weight <= ?", value)
+		case "max_cost":
+			query = query.Where(costInCopper+" <= ?", value)
+		case "weapon_kind":
+			query = query.Where("weapon @> ?::jsonb", jsonFilter(map[string]any{"kind": value}))
+		case "weapon_range":
+			query = query.Where("weapon @> ?::jsonb", jsonFilter(map[string]any{"range": value}))
+		case "damage_type":
+			query = query.Where("weapon @> ?::jsonb", jsonFilter(map[string]any{"damage_type": value}))
+		case "property":
+			query = query.Where("weapon @> ?::jsonb", jsonFilter(map[string]any{"properties": []string{value}}))
+		case "armor_type":
+			query = query.Where("armor @> ?::jsonb", jsonFilter(map[string]any{"type": value}))
+		case "stealth_disadvantage":
+			query = query.Where("armor @> ?::jsonb", jsonFilter(map[string]any{"stealth_disadvantage": value == "true"}))
+		default:
+			return nil, fmt.Errorf("unknown equipment filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&equipment).Error; err != nil {
+		return nil, err
+	}
+	return equipment, nil
+}
+
+// GetEquipmentByID retrieves an item by its ID.
+func (r *equipmentRepositoryGormImpl) GetEquipmentByID(id uuid.UUID) (*models.Equipment, error) {
+	var equipment models.Equipment
+	if err := r.db.First(&equipment, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("equipment with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &equipment, nil
+}
+
+// GetEquipmentByName retrieves an item by its name.
+func (r *equipmentRepositoryGormImpl) GetEquipmentByName(name string) (*models.Equipment, error) {
+	var equipment models.Equipment
+	if err := r.db.Where("name = ?", name).First(&equipment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("equipment with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &equipment, nil
+}
+
+// CreateEquipment adds a new item to the database.
+func (r *equipmentRepositoryGormImpl) CreateEquipment(equipment *models.Equipment) error {
+	return r.db.Create(equipment).Error
+}
+
+// UpdateEquipment replaces an existing item's details in the database.
+func (r *equipmentRepositoryGormImpl) UpdateEquipment(id uuid.UUID, equipment *models.Equipment) error {
+	var existingEquipment models.Equipment
+	if err := r.db.First(&existingEquipment, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("equipment with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	equipment.ID = existingEquipment.ID
+	return r.db.Save(equipment).Error
+}
+
+// DeleteEquipment removes an item from the database.
+func (r *equipmentRepositoryGormImpl) DeleteEquipment(id uuid.UUID) error {
+	result := r.db.Delete(&models.Equipment{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("equipment with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
+
+// jsonFilter encodes a jsonb containment filter.
+func jsonFilter(value map[string]any) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/google/uuid"
+)
+
+type EquipmentService interface {
+	ListEquipment(criteria map[string]string) ([]*models.Equipment, error)
+	GetEquipmentDetails(id uuid.UUID) (*models.Equipment, error)
+	RegisterEquipment(equipment *models.Equipment) error
+	UpdateEquipmentInfo(id uuid.UUID, equipment *models.Equipment) error
+	RemoveEquipment(id uuid.UUID) error
+}
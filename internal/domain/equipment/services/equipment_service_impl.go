@@ -0,0 +1,241 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/equipment/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// equipmentServiceImpl is the concrete implementation of EquipmentService.
+type equipmentServiceImpl struct {
+	repo repositories.EquipmentRepository
+}
+
+// NewEquipmentService creates a new instance of equipmentServiceImpl.
+func NewEquipmentService(repo repositories.EquipmentRepository) EquipmentService {
+	return &equipmentServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *equipmentServiceImpl) ListEquipment(criteria map[string]string) ([]*models.Equipment, error) {
+	if err := validateCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment filter: %w", err))
+	}
+
+	equipment, err := s.repo.GetAllEquipment(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list equipment: %w", err))
+	}
+	return equipment, nil
+}
+
+func (s *equipmentServiceImpl) GetEquipmentDetails(id uuid.UUID) (*models.Equipment, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment ID: %s", id.String()))
+	}
+
+	equipment, err := s.repo.GetEquipmentByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get equipment details by ID: %w", err))
+	}
+	return equipment, nil
+}
+
+func (s *equipmentServiceImpl) RegisterEquipment(equipment *models.Equipment) error {
+	if err := validateEquipment(equipment); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment data: %w", err))
+	}
+
+	existingEquipment, _ := s.repo.GetEquipmentByName(equipment.Name)
+	if existingEquipment != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("equipment with name '%s' already exists", equipment.Name))
+	}
+
+	if err := s.repo.CreateEquipment(equipment); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register equipment: %w", err))
+	}
+	return nil
+}
+
+func (s *equipmentServiceImpl) UpdateEquipmentInfo(id uuid.UUID, equipment *models.Equipment) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment ID: %s", id.String()))
+	}
+
+	if err := validateEquipment(equipment); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment data: %w", err))
+	}
+
+	duplicateEquipment, _ := s.repo.GetEquipmentByName(equipment.Name)
+	if duplicateEquipment != nil && duplicateEquipment.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("equipment with name '%s' already exists", equipment.Name))
+	}
+
+	if err := s.repo.UpdateEquipment(id, equipment); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update equipment info: %w", err))
+	}
+	return nil
+}
+
+func (s *equipmentServiceImpl) RemoveEquipment(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid equipment ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteEquipment(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove equipment: %w", err))
+	}
+	return nil
+}
+
+func validateCriteria(criteria map[string]string) error {
+	allowed := map[string][]string{
+		"category":     models.Categories,
+		"weapon_kind":  {models.WeaponKindSimple, models.WeaponKindMartial},
+		"weapon_range": {models.WeaponRangeMelee, models.WeaponRangeRanged},
+		"damage_type":  models.DamageTypes,
+		"property":     models.WeaponProperties,
+		"armor_type":   models.ArmorTypes,
+	}
+
+	for key, value := range criteria {
+		switch key {
+		case "category", "weapon_kind", "weapon_range", "damage_type", "property", "armor_type":
+			if !slices.Contains(allowed[key], value) {
+				return fmt.Errorf("invalid %s: %s", key, value)
+			}
+		case "max_weight":
+			if weight, err := strconv.ParseFloat(value, 64); err != nil || weight < 0 {
+				return fmt.Errorf("max_weight must be a non-negative number: %s", value)
+			}
+		case "max_cost":
+			if cost, err := strconv.Atoi(value); err != nil || cost < 0 {
+				return fmt.Errorf("max_cost must be a non-negative number of copper pieces: %s", value)
+			}
+		case "stealth_disadvantage":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("stealth_disadvantage must be true or false: %s", value)
+			}
+		case "name":
+		default:
+			return fmt.Errorf("unknown filter '%s', allowed filters are name, category, max_weight, max_cost, weapon_kind, weapon_range, damage_type, property, armor_type and stealth_disadvantage", key)
+		}
+	}
+	return nil
+}
+
+func validateEquipment(equipment *models.Equipment) error {
+	if equipment.Name == "" {
+		return errors.New("equipment name cannot be empty")
+	}
+	if !slices.Contains(models.Categories, equipment.Category) {
+		return fmt.Errorf("invalid category: %s", equipment.Category)
+	}
+	if equipment.Weight < 0 {
+		return fmt.Errorf("weight cannot be negative: %g", equipment.Weight)
+	}
+
+	if equipment.Cost.Currency == "" {
+		equipment.Cost.Currency = models.CurrencyGold
+	}
+	if !slices.Contains(models.Currencies, equipment.Cost.Currency) {
+		return fmt.Errorf("invalid currency: %s", equipment.Cost.Currency)
+	}
+	if equipment.Cost.Amount < 0 {
+		return fmt.Errorf("cost cannot be negative: %d", equipment.Cost.Amount)
+	}
+
+	if (equipment.Category == models.CategoryWeapon) != (equipment.Weapon != nil) {
+		return errors.New("weapon stats are required for weapons and only allowed for weapons")
+	}
+	if (equipment.Category == models.CategoryArmor) != (equipment.Armor != nil) {
+		return errors.New("armor stats are required for armor and only allowed for armor")
+	}
+
+	if equipment.Weapon != nil {
+		if err := validateWeapon(equipment.Weapon); err != nil {
+			return err
+		}
+	}
+	if equipment.Armor != nil {
+		if err := validateArmor(equipment.Armor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateWeapon(weapon *models.WeaponStats) error {
+	if weapon.Kind != models.WeaponKindSimple && weapon.Kind != models.WeaponKindMartial {
+		return fmt.Errorf("invalid weapon kind: %s", weapon.Kind)
+	}
+	if weapon.Range != models.WeaponRangeMelee && weapon.Range != models.WeaponRangeRanged {
+		return fmt.Errorf("invalid weapon range: %s", weapon.Range)
+	}
+	if _, err := dice.Parse(weapon.Damage); err != nil {
+		return fmt.Errorf("invalid weapon damage: %w", err)
+	}
+	if !slices.Contains(models.DamageTypes, weapon.DamageType) {
+		return fmt.Errorf("invalid damage type: %s", weapon.DamageType)
+	}
+
+	seen := make(map[string]bool)
+	for _, property := range weapon.Properties {
+		if !slices.Contains(models.WeaponProperties, property) {
+			return fmt.Errorf("invalid weapon property: %s", property)
+		}
+		if seen[property] {
+			return fmt.Errorf("duplicate weapon property: %s", property)
+		}
+		seen[property] = true
+	}
+
+	if weapon.HasProperty(models.WeaponPropertyVersatile) {
+		if _, err := dice.Parse(weapon.VersatileDamage); err != nil {
+			return fmt.Errorf("versatile weapons need a valid versatile damage: %w", err)
+		}
+	} else if weapon.VersatileDamage != "" {
+		return errors.New("versatile damage is only allowed with the versatile property")
+	}
+
+	if weapon.HasProperty(models.WeaponPropertyTwoHanded) && (weapon.HasProperty(models.WeaponPropertyLight) || weapon.HasProperty(models.WeaponPropertyVersatile)) {
+		return errors.New("two-handed weapons cannot be light or versatile")
+	}
+
+	ranged := weapon.Range == models.WeaponRangeRanged || weapon.HasProperty(models.WeaponPropertyThrown)
+	if ranged {
+		if weapon.NormalRange <= 0 || weapon.LongRange < weapon.NormalRange {
+			return fmt.Errorf("ranged and thrown weapons need a normal range and a long range at least as far: %d/%d", weapon.NormalRange, weapon.LongRange)
+		}
+	} else if weapon.NormalRange != 0 || weapon.LongRange != 0 {
+		return errors.New("range increments are only allowed for ranged and thrown weapons")
+	}
+	return nil
+}
+
+func validateArmor(armor *models.ArmorStats) error {
+	if !slices.Contains(models.ArmorTypes, armor.Type) {
+		return fmt.Errorf("invalid armor type: %s", armor.Type)
+	}
+	if armor.BaseAC <= 0 {
+		return fmt.Errorf("base armor class must be positive: %d", armor.BaseAC)
+	}
+	if armor.MaxDexBonus != nil && *armor.MaxDexBonus < 0 {
+		return fmt.Errorf("maximum dexterity bonus cannot be negative: %d", *armor.MaxDexBonus)
+	}
+	if armor.StrengthRequirement < 0 || armor.StrengthRequirement > 30 {
+		return fmt.Errorf("strength requirement must be between 0 and 30: %d", armor.StrengthRequirement)
+	}
+	if armor.Type == models.ArmorTypeShield && (armor.MaxDexBonus != nil || armor.StrengthRequirement != 0 || armor.StealthDisadvantage) {
+		return errors.New("shields only define the armor class bonus they add")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type LanguageController interface {
+	GetAllLanguages(ctx *gin.Context)
+	GetLanguageByID(ctx *gin.Context)
+	CreateLanguage(ctx *gin.Context)
+	UpdateLanguage(ctx *gin.Context)
+	DeleteLanguage(ctx *gin.Context)
+	GetLanguageSpeakers(ctx *gin.Context)
+}
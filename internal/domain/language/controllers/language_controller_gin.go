@@ -0,0 +1,210 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/language/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// languageControllerGin is a concrete implementation of LanguageController using the Gin framework.
+type languageControllerGin struct {
+	service services.LanguageService
+}
+
+// NewLanguageControllerGin creates a new instance of languageControllerGin.
+func NewLanguageControllerGin(service services.LanguageService) LanguageController {
+	return &languageControllerGin{
+		service: service,
+	}
+}
+
+// GetAllLanguages godoc
+// @Summary      List all languages
+// @Description  Return all registered languages, optionally filtered by type
+// @Tags         Languages
+// @Accept       json
+// @Produce      json
+// @Param        type  query     string  false  "Language type (standard or exotic)"
+// @Success      200   {array}   models.Language
+// @Failure      400   {object}  httperror.ErrorResponse
+// @Failure      500   {object}  httperror.ErrorResponse
+// @Router       /languages [get]
+func (c *languageControllerGin) GetAllLanguages(ctx *gin.Context) {
+	languages, err := c.service.ListLanguages(ctx.Query("type"))
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, languages)
+}
+
+// GetLanguageByID godoc
+// @Summary      Get language by ID
+// @Description  Retrieve a language using the provided ID
+// @Tags         Languages
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Language ID (UUID)"
+// @Success      200  {object}  models.Language
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /languages/{id} [get]
+func (c *languageControllerGin) GetLanguageByID(ctx *gin.Context) {
+	id, err := parseLanguageID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	language, err := c.service.GetLanguageDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, language)
+}
+
+// CreateLanguage godoc
+// @Summary      Create language
+// @Description  Create a new language
+// @Tags         Languages
+// @Accept       json
+// @Produce      json
+// @Param        language  body      models.Language  true  "Language info"
+// @Success      201       {object}  models.Language
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      409       {object}  httperror.ErrorResponse
+// @Failure      500       {object}  httperror.ErrorResponse
+// @Router       /languages [post]
+func (c *languageControllerGin) CreateLanguage(ctx *gin.Context) {
+	var language models.Language
+	if err := ctx.ShouldBindJSON(&language); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterLanguage(&language); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, language)
+}
+
+// UpdateLanguage godoc
+// @Summary      Update language
+// @Description  Update an existing language
+// @Tags         Languages
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string           true  "Language ID (UUID)"
+// @Param        language  body      models.Language  true  "Language info"
+// @Success      200       {object}  models.Language
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      404       {object}  httperror.ErrorResponse
+// @Failure      409       {object}  httperror.ErrorResponse
+// @Router       /languages/{id} [put]
+func (c *languageControllerGin) UpdateLanguage(ctx *gin.Context) {
+	id, err := parseLanguageID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var language models.Language
+	if err := ctx.ShouldBindJSON(&language); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateLanguageInfo(id, &language); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, language)
+}
+
+// DeleteLanguage godoc
+// @Summary      Delete language
+// @Description  Delete an existing language. Languages still known by a race or subrace are only deleted when force is true.
+// @Tags         Languages
+// @Param        id     path   string  true   "Language ID (UUID)"
+// @Param        force  query  bool    false  "Also remove the language from every race and subrace knowing it"
+// @Success      204
+// @Failure      400 {object} httperror.ErrorResponse
+// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      409 {object} httperror.ErrorResponse
+// @Router       /languages/{id} [delete]
+func (c *languageControllerGin) DeleteLanguage(ctx *gin.Context) {
+	id, err := parseLanguageID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	force, err := strconv.ParseBool(ctx.DefaultQuery("force", "false"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid force flag: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveLanguage(id, force); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetLanguageSpeakers godoc
+// @Summary      List language speakers
+// @Description  Return the races and subraces that know the language
+// @Tags         Languages
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Language ID (UUID)"
+// @Success      200  {object}  models.LanguageSpeakers
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /languages/{id}/speakers [get]
+func (c *languageControllerGin) GetLanguageSpeakers(ctx *gin.Context) {
+	id, err := parseLanguageID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	speakers, err := c.service.ListLanguageSpeakers(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, speakers)
+}
+
+// parseLanguageID reads the language ID path parameter.
+func parseLanguageID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language ID: %w", err))
+	}
+	return id, nil
+}
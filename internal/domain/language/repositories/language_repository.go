@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type LanguageRepository interface {
+	GetAllLanguages(languageType string) ([]*models.Language, error)
+	GetLanguageByID(id uuid.UUID) (*models.Language, error)
+	GetLanguageByName(name string) (*models.Language, error)
+	CreateLanguage(language *models.Language) error
+	UpdateLanguage(id uuid.UUID, language *models.Language) error
+	DeleteLanguage(id uuid.UUID, force bool) error
+	GetRacesByLanguage(id uuid.UUID) ([]*models.Race, error)
+	GetSubracesByLanguage(id uuid.UUID) ([]*models.Subrace, error)
+}
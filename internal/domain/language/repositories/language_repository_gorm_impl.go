@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// languageRepositoryGormImpl is a concrete implementation of the LanguageRepository interface using GORM.
+type languageRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormLanguageRepository creates a new instance of languageRepositoryGormImpl.
+func NewGormLanguageRepository(db *gorm.DB) LanguageRepository {
+	return &languageRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllLanguages retrieves all languages ordered by name, optionally restricted to one language type.
+func (r *languageRepositoryGormImpl) GetAllLanguages(languageType string) ([]*models.Language, error) {
+	var languages []*models.Language
+	query := r.db.Order("name")
+	if languageType != "" {
+		query = query.Where("type = ?", languageType)
+	}
+	if err := query.Find(&languages).Error; err != nil {
+		return nil, err
+	}
+	return languages, nil
+}
+
+// GetLanguageByID retrieves a language by its ID.
+func (r *languageRepositoryGormImpl) GetLanguageByID(id uuid.UUID) (*models.Language, error) {
+	var language models.Language
+	if err := r.db.First(&language, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("language with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &language, nil
+}
+
+// GetLanguageByName retrieves a language by its name.
+func (r *languageRepositoryGormImpl) GetLanguageByName(name string) (*models.Language, error) {
+	var language models.Language
+	if err := r.db.Where("name = ?", name).First(&language).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("language with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &language, nil
+}
+
+// CreateLanguage adds a new language to the database.
+func (r *languageRepositoryGormImpl) CreateLanguage(language *models.Language) error {
+	return r.db.Create(language).Error
+}
+
+// UpdateLanguage updates an existing language's details in the database.
+func (r *languageRepositoryGormImpl) UpdateLanguage(id uuid.UUID, language *models.Language) error {
+	var existingLanguage models.Language
+	if err := r.db.First(&existingLanguage, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("language with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	existingLanguage.Name = language.Name
+	existingLanguage.Type = language.Type
+	existingLanguage.Script = language.Script
+	existingLanguage.TypicalSpeakers = language.TypicalSpeakers
+
+	if err := r.db.Save(&existingLanguage).Error; err != nil {
+		return err
+	}
+
+	*language = existingLanguage
+	return nil
+}
+
+// DeleteLanguage removes a language from the database. A language still known by a race or subrace
+// is only removed when force is set, in which case its race and subrace associations are dropped first.
+func (r *languageRepositoryGormImpl) DeleteLanguage(id uuid.UUID, force bool) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var language models.Language
+	if err := tx.First(&language, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("language with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	var raceUsages, subraceUsages int64
+	if err := tx.Table("race_languages").Where("language_id = ?", id).Count(&raceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Table("subrace_languages").Where("language_id = ?", id).Count(&subraceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if raceUsages+subraceUsages > 0 && !force {
+		tx.Rollback()
+		return fmt.Errorf("language with ID %s is still known by %d race(s) and %d subrace(s): %w", id.String(), raceUsages, subraceUsages, failure.ErrorConflict)
+	}
+
+	if err := tx.Exec("DELETE FROM race_languages WHERE language_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Exec("DELETE FROM subrace_languages WHERE language_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(&language).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetRacesByLanguage retrieves every race that knows the language.
+func (r *languageRepositoryGormImpl) GetRacesByLanguage(id uuid.UUID) ([]*models.Race, error) {
+	var races []*models.Race
+	if err := r.db.Joins("JOIN race_languages ON race_languages.race_id = races.id").
+		Where("race_languages.language_id = ?", id).
+		Order("races.name").
+		Find(&races).Error; err != nil {
+		return nil, err
+	}
+	return races, nil
+}
+
+// GetSubracesByLanguage retrieves every subrace that knows the language, either directly
+// or through its parent race.
+func (r *languageRepositoryGormImpl) GetSubracesByLanguage(id uuid.UUID) ([]*models.Subrace, error) {
+	var subraces []*models.Subrace
+	if err := r.db.Where("id IN (SELECT subrace_id FROM subrace_languages WHERE language_id = ?)", id).
+		Or("race_id IN (SELECT race_id FROM race_languages WHERE language_id = ?)", id).
+		Order("name").
+		Find(&subraces).Error; err != nil {
+		return nil, err
+	}
+	return subraces, nil
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type LanguageService interface {
+	ListLanguages(languageType string) ([]*models.Language, error)
+	GetLanguageDetails(id uuid.UUID) (*models.Language, error)
+	RegisterLanguage(language *models.Language) error
+	UpdateLanguageInfo(id uuid.UUID, language *models.Language) error
+	RemoveLanguage(id uuid.UUID, force bool) error
+	ListLanguageSpeakers(id uuid.UUID) (*models.LanguageSpeakers, error)
+}
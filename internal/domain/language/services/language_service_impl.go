@@ -0,0 +1,139 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/language/repositories"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// languageServiceImpl is the concrete implementation of LanguageService.
+type languageServiceImpl struct {
+	repo repositories.LanguageRepository
+}
+
+// NewLanguageService creates a new instance of languageServiceImpl.
+func NewLanguageService(repo repositories.LanguageRepository) LanguageService {
+	return &languageServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *languageServiceImpl) ListLanguages(languageType string) ([]*models.Language, error) {
+	if languageType != "" && !validLanguageType(languageType) {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language type: %s", languageType))
+	}
+
+	languages, err := s.repo.GetAllLanguages(languageType)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list languages: %w", err))
+	}
+	return languages, nil
+}
+
+func (s *languageServiceImpl) GetLanguageDetails(id uuid.UUID) (*models.Language, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language ID: %s", id.String()))
+	}
+
+	language, err := s.repo.GetLanguageByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get language details by ID: %w", err))
+	}
+	return language, nil
+}
+
+func (s *languageServiceImpl) RegisterLanguage(language *models.Language) error {
+	if err := validateLanguage(language); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language data: %w", err))
+	}
+
+	existingLanguage, _ := s.repo.GetLanguageByName(language.Name)
+	if existingLanguage != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("language with name '%s' already exists", language.Name))
+	}
+
+	if err := s.repo.CreateLanguage(language); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register language: %w", err))
+	}
+	return nil
+}
+
+func (s *languageServiceImpl) UpdateLanguageInfo(id uuid.UUID, language *models.Language) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language ID: %s", id.String()))
+	}
+
+	if err := validateLanguage(language); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language data: %w", err))
+	}
+
+	duplicateLanguage, _ := s.repo.GetLanguageByName(language.Name)
+	if duplicateLanguage != nil && duplicateLanguage.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("language with name '%s' already exists", language.Name))
+	}
+
+	if err := s.repo.UpdateLanguage(id, language); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update language info: %w", err))
+	}
+	return nil
+}
+
+func (s *languageServiceImpl) RemoveLanguage(id uuid.UUID, force bool) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid language ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteLanguage(id, force); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove language: %w", err))
+	}
+	return nil
+}
+
+func (s *languageServiceImpl) ListLanguageSpeakers(id uuid.UUID) (*models.LanguageSpeakers, error) {
+	language, err := s.GetLanguageDetails(id)
+	if err != nil {
+		return nil, err
+	}
+
+	races, err := s.repo.GetRacesByLanguage(id)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to list races speaking language: %w", err))
+	}
+
+	subraces, err := s.repo.GetSubracesByLanguage(id)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to list subraces speaking language: %w", err))
+	}
+
+	return &models.LanguageSpeakers{
+		Language: language,
+		Races:    races,
+		Subraces: subraces,
+	}, nil
+}
+
+func validLanguageType(languageType string) bool {
+	return languageType == models.LanguageTypeStandard || languageType == models.LanguageTypeExotic
+}
+
+func validateLanguage(language *models.Language) error {
+	if language.Name == "" {
+		return errors.New("language name cannot be empty")
+	}
+	if language.Type == "" {
+		language.Type = models.LanguageTypeStandard
+	}
+	if !validLanguageType(language.Type) {
+		return fmt.Errorf("invalid language type: %s", language.Type)
+	}
+	for _, speaker := range language.TypicalSpeakers {
+		if speaker == "" {
+			return errors.New("typical speaker cannot be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ProficiencyController interface {
+	GetAllProficiencies(ctx *gin.Context)
+	GetProficiencyByID(ctx *gin.Context)
+	CreateProficiency(ctx *gin.Context)
+	UpdateProficiency(ctx *gin.Context)
+	DeleteProficiency(ctx *gin.Context)
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// proficiencyControllerGin is a concrete implementation of ProficiencyController using the Gin framework.
+type proficiencyControllerGin struct {
+	service services.ProficiencyService
+}
+
+// NewProficiencyControllerGin creates a new instance of proficiencyControllerGin.
+func NewProficiencyControllerGin(service services.ProficiencyService) ProficiencyController {
+	return &proficiencyControllerGin{
+		service: service,
+	}
+}
+
+// GetAllProficiencies godoc
+// @Summary      List all proficiencies
+// @Description  Return all registered proficiencies, optionally filtered by category, governing ability or name
+// @Tags         Proficiencies
+// @Accept       json
+// @Produce      json
+// @Param        category  query     string  false  "Category (skill, tool, weapon, armor or saving_throw)"
+// @Param        ability   query     string  false  "Governing ability"
+// @Param        name      query     string  false  "Case-insensitive name fragment"
+// @Success      200       {array}   models.Proficiency
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      500       {object}  httperror.ErrorResponse
+// @Router       /proficiencies [get]
+func (c *proficiencyControllerGin) GetAllProficiencies(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	proficiencies, err := c.service.ListProficiencies(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, proficiencies)
+}
+
+// GetProficiencyByID godoc
+// @Summary      Get proficiency by ID
+// @Description  Retrieve a proficiency using the provided ID
+// @Tags         Proficiencies
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Proficiency ID (UUID)"
+// @Success      200  {object}  models.Proficiency
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /proficiencies/{id} [get]
+func (c *proficiencyControllerGin) GetProficiencyByID(ctx *gin.Context) {
+	id, err := parseProficiencyID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	proficiency, err := c.service.GetProficiencyDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, proficiency)
+}
+
+// CreateProficiency godoc
+// @Summary      Create proficiency
+// @Description  Create a new proficiency
+// @Tags         Proficiencies
+// @Accept       json
+// @Produce      json
+// @Param        proficiency  body      models.Proficiency  true  "Proficiency info"
+// @Success      201          {object}  models.Proficiency
+// @Failure      400          {object}  httperror.ErrorResponse
+// @Failure      409          {object}  httperror.ErrorResponse
+// @Failure      500          {object}  httperror.ErrorResponse
+// @Router       /proficiencies [post]
+func (c *proficiencyControllerGin) CreateProficiency(ctx *gin.Context) {
+	var proficiency models.Proficiency
+	if err := ctx.ShouldBindJSON(&proficiency); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterProficiency(&proficiency); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, proficiency)
+}
+
+// UpdateProficiency godoc
+// @Summary      Update proficiency
+// @Description  Update an existing proficiency
+// @Tags         Proficiencies
+// @Accept       json
+// @Produce      json
+// @Param        id           path      string              true  "Proficiency ID (UUID)"
+// @Param        proficiency  body      models.Proficiency  true  "Proficiency info"
+// @Success      200          {object}  models.Proficiency
+// @Failure      400          {object}  httperror.ErrorResponse
+// @Failure      404          {object}  httperror.ErrorResponse
+// @Failure      409          {object}  httperror.ErrorResponse
+// @Router       /proficiencies/{id} [put]
+func (c *proficiencyControllerGin) UpdateProficiency(ctx *gin.Context) {
+	id, err := parseProficiencyID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var proficiency models.Proficiency
+	if err := ctx.ShouldBindJSON(&proficiency); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateProficiencyInfo(id, &proficiency); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, proficiency)
+}
+
+// DeleteProficiency godoc
+// @Summary      Delete proficiency
+// @Description  Delete an existing proficiency. Proficiencies still granted by a race or subrace are only deleted when force is true.
+// @Tags         Proficiencies
+// @Param        id     path   string  true   "Proficiency ID (UUID)"
+// @Param        force  query  bool    false  "Also remove the proficiency from every race and subrace granting it"
+// @Success      204
+// @Failure      400 {object} httperror.ErrorResponse
+// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      409 {object} httperror.ErrorResponse
+// @Router       /proficiencies/{id} [delete]
+func (c *proficiencyControllerGin) DeleteProficiency(ctx *gin.Context) {
+	id, err := parseProficiencyID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	force, err := strconv.ParseBool(ctx.DefaultQuery("force", "false"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid force flag: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveProficiency(id, force); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseProficiencyID reads the proficiency ID path parameter.
+func parseProficiencyID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency ID: %w", err))
+	}
+	return id, nil
+}
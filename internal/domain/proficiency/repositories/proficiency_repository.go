@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type ProficiencyRepository interface {
+	GetAllProficiencies(criteria map[string]string) ([]*models.Proficiency, error)
+	GetProficiencyByID(id uuid.UUID) (*models.Proficiency, error)
+	GetProficiencyByName(name string) (*models.Proficiency, error)
+	CreateProficiency(proficiency *models.Proficiency) error
+	UpdateProficiency(id uuid.UUID, proficiency *models.Proficiency) error
+	DeleteProficiency(id uuid.UUID, force bool) error
+}
@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// proficiencyRepositoryGormImpl is a concrete implementation of the ProficiencyRepository interface using GORM.
+type proficiencyRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormProficiencyRepository creates a new instance of proficiencyRepositoryGormImpl.
+func NewGormProficiencyRepository(db *gorm.DB) ProficiencyRepository {
+	return &proficiencyRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllProficiencies retrieves the proficiencies matching the given criteria ordered by category and name.
+func (r *proficiencyRepositoryGormImpl) GetAllProficiencies(criteria map[string]string) ([]*models.Proficiency, error) {
+	var proficiencies []*models.Proficiency
+	query := r.db.Order("category").Order("name")
+
+	for key, value := range criteria {
+		switch key {
+		case "category":
+			query = query.Where("category = ?", value)
+		case "ability":
+			query = query.Where("ability = ?", value)
+		case "name":
+			query = query.Where("name ILIKE ?", "%"+value+"%")
+		default:
+			return nil, fmt.Errorf("unknown proficiency filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&proficiencies).Error; err != nil {
+		return nil, err
+	}
+	return proficiencies, nil
+}
+
+// GetProficiencyByID retrieves a proficiency by its ID.
+func (r *proficiencyRepositoryGormImpl) GetProficiencyByID(id uuid.UUID) (*models.Proficiency, error) {
+	var proficiency models.Proficiency
+	if err := r.db.First(&proficiency, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("proficiency with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &proficiency, nil
+}
+
+// GetProficiencyByName retrieves a proficiency by its name.
+func (r *proficiencyRepositoryGormImpl) GetProficiencyByName(name string) (*models.Proficiency, error) {
+	var proficiency models.Proficiency
+	if err := r.db.Where("name = ?", name).First(&proficiency).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("proficiency with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &proficiency, nil
+}
+
+// CreateProficiency adds a new proficiency to the database.
+func (r *proficiencyRepositoryGormImpl) CreateProficiency(proficiency *models.Proficiency) error {
+	return r.db.Create(proficiency).Error
+}
+
+// UpdateProficiency updates an existing proficiency's details in the database.
+func (r *proficiencyRepositoryGormImpl) UpdateProficiency(id uuid.UUID, proficiency *models.Proficiency) error {
+	var existingProficiency models.Proficiency
+	if err := r.db.First(&existingProficiency, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("proficiency with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	existingProficiency.Name = proficiency.Name
+	existingProficiency.Description = proficiency.Description
+	existingProficiency.Category = proficiency.Category
+	existingProficiency.Ability = proficiency.Ability
+
+	if err := r.db.Save(&existingProficiency).Error; err != nil {
+		return err
+	}
+
+	*proficiency = existingProficiency
+	return nil
+}
+
+// DeleteProficiency removes a proficiency from the database. A proficiency still granted by a race or subrace
+// is only removed when force is set, in which case its race and subrace associations are dropped first.
+func (r *proficiencyRepositoryGormImpl) DeleteProficiency(id uuid.UUID, force bool) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var proficiency models.Proficiency
+	if err := tx.First(&proficiency, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("proficiency with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	var raceUsages, subraceUsages int64
+	if err := tx.Table("race_proficiencies").Where("proficiency_id = ?", id).Count(&raceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Table("subrace_proficiencies").Where("proficiency_id = ?", id).Count(&subraceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if raceUsages+subraceUsages > 0 && !force {
+		tx.Rollback()
+		return fmt.Errorf("proficiency with ID %s is still granted by %d race(s) and %d subrace(s): %w", id.String(), raceUsages, subraceUsages, failure.ErrorConflict)
+	}
+
+	if err := tx.Exec("DELETE FROM race_proficiencies WHERE proficiency_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Exec("DELETE FROM subrace_proficiencies WHERE proficiency_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(&proficiency).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
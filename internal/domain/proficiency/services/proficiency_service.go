@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type ProficiencyService interface {
+	ListProficiencies(criteria map[string]string) ([]*models.Proficiency, error)
+	GetProficiencyDetails(id uuid.UUID) (*models.Proficiency, error)
+	RegisterProficiency(proficiency *models.Proficiency) error
+	UpdateProficiencyInfo(id uuid.UUID, proficiency *models.Proficiency) error
+	RemoveProficiency(id uuid.UUID, force bool) error
+	ResolveProficiencies(proficiencies []models.Proficiency) error
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/repositories"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+var validCategories = []string{
+	models.ProficiencyCategorySkill,
+	models.ProficiencyCategoryTool,
+	models.ProficiencyCategoryWeapon,
+	models.ProficiencyCategoryArmor,
+	models.ProficiencyCategorySavingThrow,
+}
+
+// proficiencyServiceImpl is the concrete implementation of ProficiencyService.
+type proficiencyServiceImpl struct {
+	repo repositories.ProficiencyRepository
+}
+
+// NewProficiencyService creates a new instance of proficiencyServiceImpl.
+func NewProficiencyService(repo repositories.ProficiencyRepository) ProficiencyService {
+	return &proficiencyServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *proficiencyServiceImpl) ListProficiencies(criteria map[string]string) ([]*models.Proficiency, error) {
+	if err := validateCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency filter: %w", err))
+	}
+
+	proficiencies, err := s.repo.GetAllProficiencies(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list proficiencies: %w", err))
+	}
+	return proficiencies, nil
+}
+
+func (s *proficiencyServiceImpl) GetProficiencyDetails(id uuid.UUID) (*models.Proficiency, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency ID: %s", id.String()))
+	}
+
+	proficiency, err := s.repo.GetProficiencyByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get proficiency details by ID: %w", err))
+	}
+	return proficiency, nil
+}
+
+func (s *proficiencyServiceImpl) RegisterProficiency(proficiency *models.Proficiency) error {
+	if err := validateProficiency(proficiency); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency data: %w", err))
+	}
+
+	existingProficiency, _ := s.repo.GetProficiencyByName(proficiency.Name)
+	if existingProficiency != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("proficiency with name '%s' already exists", proficiency.Name))
+	}
+
+	if err := s.repo.CreateProficiency(proficiency); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register proficiency: %w", err))
+	}
+	return nil
+}
+
+func (s *proficiencyServiceImpl) UpdateProficiencyInfo(id uuid.UUID, proficiency *models.Proficiency) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency ID: %s", id.String()))
+	}
+
+	if err := validateProficiency(proficiency); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency data: %w", err))
+	}
+
+	duplicateProficiency, _ := s.repo.GetProficiencyByName(proficiency.Name)
+	if duplicateProficiency != nil && duplicateProficiency.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("proficiency with name '%s' already exists", proficiency.Name))
+	}
+
+	if err := s.repo.UpdateProficiency(id, proficiency); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update proficiency info: %w", err))
+	}
+	return nil
+}
+
+func (s *proficiencyServiceImpl) RemoveProficiency(id uuid.UUID, force bool) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid proficiency ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteProficiency(id, force); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove proficiency: %w", err))
+	}
+	return nil
+}
+
+func (s *proficiencyServiceImpl) ResolveProficiencies(proficiencies []models.Proficiency) error {
+	for i, prof := range proficiencies {
+		var entry *models.Proficiency
+		var err error
+		reference := fmt.Sprintf("'%s'", prof.Name)
+		if prof.ID != uuid.Nil {
+			entry, err = s.repo.GetProficiencyByID(prof.ID)
+			reference = "with ID " + prof.ID.String()
+		} else if prof.Name != "" {
+			entry, err = s.repo.GetProficiencyByName(prof.Name)
+		} else {
+			return failure.NewError(failure.ErrorBadRequest, errors.New("proficiency needs an ID or a name"))
+		}
+		if err != nil {
+			if errors.Is(err, failure.ErrorNotFound) {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency %s does not exist, add it to the proficiency catalog first", reference))
+			}
+			return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to look up proficiency: %w", err))
+		}
+
+		differs := func(value, catalog string) bool { return value != "" && value != catalog }
+		if differs(prof.Name, entry.Name) || differs(prof.Description, entry.Description) ||
+			differs(prof.Category, entry.Category) || differs(prof.Ability, entry.Ability) {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency '%s' differs from the catalog, link it by ID or name and edit it through /proficiencies", entry.Name))
+		}
+		proficiencies[i] = *entry
+	}
+	return nil
+}
+
+func validateCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "category":
+			if !slices.Contains(validCategories, value) {
+				return fmt.Errorf("invalid category: %s", value)
+			}
+		case "ability":
+			if !slices.Contains(models.Abilities, value) {
+				return fmt.Errorf("invalid ability: %s", value)
+			}
+		case "name":
+		default:
+			return fmt.Errorf("unknown filter '%s', allowed filters are category, ability and name", key)
+		}
+	}
+	return nil
+}
+
+func validateProficiency(proficiency *models.Proficiency) error {
+	if proficiency.Name == "" {
+		return errors.New("proficiency name cannot be empty")
+	}
+	if !slices.Contains(validCategories, proficiency.Category) {
+		return fmt.Errorf("invalid category: %s", proficiency.Category)
+	}
+	if proficiency.Ability != "" && !slices.Contains(models.Abilities, proficiency.Ability) {
+		return fmt.Errorf("invalid ability: %s", proficiency.Ability)
+	}
+
+	switch proficiency.Category {
+	case models.ProficiencyCategorySkill, models.ProficiencyCategorySavingThrow:
+		if proficiency.Ability == "" {
+			return fmt.Errorf("%s proficiency requires a governing ability", proficiency.Category)
+		}
+	case models.ProficiencyCategoryWeapon, models.ProficiencyCategoryArmor:
+		if proficiency.Ability != "" {
+			return fmt.Errorf("%s proficiency cannot have a governing ability", proficiency.Category)
+		}
+	}
+	return nil
+}
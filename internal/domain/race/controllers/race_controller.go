@@ -9,10 +9,13 @@ type RaceController interface {
 	GetRaceByID(ctx *gin.Context)
 	CreateRace(ctx *gin.Context)
 	UpdateRace(ctx *gin.Context)
+	PatchRace(ctx *gin.Context)
 	DeleteRace(ctx *gin.Context)
 	AddSubrace(ctx *gin.Context)
 	RemoveSubrace(ctx *gin.Context)
 	AddTrait(ctx *gin.Context)
 	RemoveTrait(ctx *gin.Context)
 	SearchRaces(ctx *gin.Context)
+	GetEffectiveRace(ctx *gin.Context)
+	ResolveAbilityBonuses(ctx *gin.Context)
 }
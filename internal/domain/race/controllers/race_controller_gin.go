@@ -1,10 +1,16 @@
 package controllers
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
 	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -23,21 +29,52 @@ func NewRaceControllerGin(service services.RaceService) RaceController {
 }
 
 // GetAllRaces godoc
-// @Summary      List all races
-// @Description  Return all registered races
+// @Summary      List races
+// @Description  Return one page of races. Pages are selected with limit and either offset or the cursor of the previous page, which stays stable while races are added. The total number of races is returned in the X-Total-Count header and the first, previous, next and last pages in the Link header. Every association is loaded by default, as before pagination; once include or fields is given, only the associations it names are loaded, and an empty include loads none.
 // @Tags         Races
 // @Accept       json
 // @Produce      json
-// @Success      200 {array}  models.Race
-// @Failure      500 {object} httperror.ErrorResponse
+// @Param        limit    query     int     false  "Races per page, 20 by default and at most 100"
+// @Param        offset   query     int     false  "Number of races to skip"
+// @Param        cursor   query     string  false  "Cursor of the next page from the Link or X-Next-Cursor header"
+// @Param        sort     query     string  false  "Comma-separated sort fields among name, size, speed and alignment, prefixed with - for descending order, name by default"
+// @Param        fields   query     string  false  "Comma-separated fields of each race to return"
+// @Param        include  query     string  false  "Comma-separated associations to load among age, proficiencies, languages_known, traits and subraces, all by default and none when empty"
+// @Success      200      {array}   models.Race
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Header       200      {integer}  X-Total-Count  "Total number of races"
+// @Header       200      {string}   Link           "Links to the first, previous, next and last pages"
+// @Header       200      {string}   X-Next-Cursor  "Cursor of the next page, absent on the last page"
 // @Router       /races [get]
 func (c *raceControllerGin) GetAllRaces(ctx *gin.Context) {
-	races, err := c.service.ListRaces()
+	params := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+
+	page, err := c.service.ListRaces(params)
 	if err != nil {
 		apiError := httperror.FormError(err)
 		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
 		return
 	}
+
+	ctx.Header("X-Total-Count", strconv.FormatInt(page.Total, 10))
+	if page.NextCursor != "" {
+		ctx.Header("X-Next-Cursor", page.NextCursor)
+	}
+	if links := racePageLinks(ctx.Request.URL, page); len(links) > 0 {
+		ctx.Header("Link", strings.Join(links, ", "))
+	}
+
+	fields := page.Query.SelectedFields()
+	races := make([]map[string]json.RawMessage, len(page.Races))
+	for i, race := range page.Races {
+		races[i] = race.Project(fields)
+	}
 	ctx.JSON(http.StatusOK, races)
 }
 
@@ -72,7 +109,7 @@ func (c *raceControllerGin) GetRaceByID(ctx *gin.Context) {
 
 // CreateRace godoc
 // @Summary      Create race
-// @Description  Create a new race
+// @Description  Create a new race. Proficiencies, including those of subraces, link catalog proficiencies by ID or name; unknown ones are rejected.
 // @Tags         Races
 // @Accept       json
 // @Produce      json
@@ -99,7 +136,7 @@ func (c *raceControllerGin) CreateRace(ctx *gin.Context) {
 
 // UpdateRace godoc
 // @Summary      Update race
-// @Description  Update an existing race
+// @Description  Update an existing race. Proficiencies, including those of subraces, link catalog proficiencies by ID or name; unknown ones are rejected.
 // @Tags         Races
 // @Accept       json
 // @Produce      json
@@ -133,14 +170,53 @@ func (c *raceControllerGin) UpdateRace(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, race)
 }
 
+// PatchRace godoc
+// @Summary      Patch race
+// @Description  Change part of a race without resending it. Send a JSON Merge Patch (RFC 7396) as application/merge-patch+json or application/json, or a JSON Patch (RFC 6902) as application/json-patch+json. Only the fields the patch changes are written, and the patched race is validated like a new one. Subraces listed with their ID are updated, those without an ID are added and omitted ones are removed. Languages and traits are linked by the ID of a catalog entry and proficiencies by ID or name; their own fields are edited through their catalogs, so a patch changing them is rejected. A failing test operation returns 409.
+// @Tags         Races
+// @Accept       application/merge-patch+json,application/json-patch+json,json
+// @Produce      json
+// @Param        id     path      string  true  "Race ID (UUID)"
+// @Param        patch  body      object  true  "Merge patch object or array of JSON Patch operations"
+// @Success      200    {object}  models.Race
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Failure      409    {object}  httperror.ErrorResponse
+// @Failure      415    {object}  httperror.ErrorResponse
+// @Failure      500    {object}  httperror.ErrorResponse
+// @Router       /races/{id} [patch]
+func (c *raceControllerGin) PatchRace(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	patch, err := ctx.GetRawData()
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	race, err := c.service.PatchRace(id, ctx.ContentType(), patch)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, race)
+}
+
 // DeleteRace godoc
 // @Summary      Delete race
 // @Description  Delete an existing race
 // @Tags         Races
 // @Param        id   path      string  true  "Race ID (UUID)"
 // @Success      204
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
 // @Router       /races/{id} [delete]
 func (c *raceControllerGin) DeleteRace(ctx *gin.Context) {
 	idStr := ctx.Param("id")
@@ -167,9 +243,9 @@ func (c *raceControllerGin) DeleteRace(ctx *gin.Context) {
 // @Produce      json
 // @Param        id       path      string          true  "Race ID (UUID)"
 // @Param        subrace  body      models.Subrace  true  "Subrace info"
-// @Success      201 {object} models.Subrace
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      500 {object} httperror.ErrorResponse
+// @Success      201      {object}  models.Subrace
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
 // @Router       /races/{id}/subraces [post]
 func (c *raceControllerGin) AddSubrace(ctx *gin.Context) {
 	idStr := ctx.Param("id")
@@ -201,11 +277,11 @@ func (c *raceControllerGin) AddSubrace(ctx *gin.Context) {
 // @Tags         Races
 // @Accept       json
 // @Produce      json
-// @Param        id         path  string true "Race ID (UUID)"
-// @Param        subraceID  path  string true "Subrace ID (UUID)"
+// @Param        id         path      string  true  "Race ID (UUID)"
+// @Param        subraceID  path      string  true  "Subrace ID (UUID)"
 // @Success      204
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
 // @Router       /races/{id}/subraces/{subraceID} [delete]
 func (c *raceControllerGin) RemoveSubrace(ctx *gin.Context) {
 	idStr := ctx.Param("id")
@@ -238,11 +314,11 @@ func (c *raceControllerGin) RemoveSubrace(ctx *gin.Context) {
 // @Tags         Races
 // @Accept       json
 // @Produce      json
-// @Param        id       path  string  true  "Race ID (UUID)"
-// @Param        traitID  path  string  true  "Trait ID (UUID)"
+// @Param        id       path      string  true  "Race ID (UUID)"
+// @Param        traitID  path      string  true  "Trait ID (UUID)"
 // @Success      201
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
 // @Router       /races/{id}/traits/{traitID} [post]
 func (c *raceControllerGin) AddTrait(ctx *gin.Context) {
 	idStr := ctx.Param("id")
@@ -275,11 +351,11 @@ func (c *raceControllerGin) AddTrait(ctx *gin.Context) {
 // @Tags         Races
 // @Accept       json
 // @Produce      json
-// @Param        id       path  string  true  "Race ID (UUID)"
-// @Param        traitID  path  string  true  "Trait ID (UUID)"
+// @Param        id       path      string  true  "Race ID (UUID)"
+// @Param        traitID  path      string  true  "Trait ID (UUID)"
 // @Success      204
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
 // @Router       /races/{id}/traits/{traitID} [delete]
 func (c *raceControllerGin) RemoveTrait(ctx *gin.Context) {
 	idStr := ctx.Param("id")
@@ -308,15 +384,21 @@ func (c *raceControllerGin) RemoveTrait(ctx *gin.Context) {
 
 // SearchRaces godoc
 // @Summary      Search races
-// @Description  Search for races based on query parameters
+// @Description  Search races with filters written as field=value or field[operator]=value, for example speed[gte]=30, name[ilike]=elf, trait=Darkvision or bonus.dexterity[gt]=0. Filters are combined with AND. Filters prefixed with or.<group>., such as or.1.size=Small&or.1.speed[gte]=35, match when any filter of their group does. The operators are eq, ne, gt, gte, lt, lte, like, ilike and in, which takes comma-separated values. trait, language, proficiency and subrace match the names of the related entities.
 // @Tags         Races
 // @Accept       json
 // @Produce      json
-// @Param        key    query  string false "Key to filter"
-// @Param        value  query  string false "Value to filter"
-// @Success      200 {array}  models.Race
-// @Failure      400 {object} httperror.ErrorResponse
-// @Failure      500 {object} httperror.ErrorResponse
+// @Param        name         query     string  false  "Race name"
+// @Param        size         query     string  false  "Size"
+// @Param        speed        query     int     false  "Walking speed in feet"
+// @Param        alignment    query     string  false  "Alignment"
+// @Param        trait        query     string  false  "Name of a trait of the race"
+// @Param        language     query     string  false  "Name of a language of the race"
+// @Param        proficiency  query     string  false  "Name of a proficiency of the race"
+// @Param        subrace      query     string  false  "Name of a subrace of the race"
+// @Success      200          {array}   models.Race
+// @Failure      400          {object}  httperror.ErrorResponse
+// @Failure      500          {object}  httperror.ErrorResponse
 // @Router       /races/search [get]
 func (c *raceControllerGin) SearchRaces(ctx *gin.Context) {
 	criteria := make(map[string]string)
@@ -335,3 +417,105 @@ func (c *raceControllerGin) SearchRaces(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, races)
 }
+
+// GetEffectiveRace godoc
+// @Summary      Get effective race
+// @Description  Merge a race with one of its subraces into a single profile, recording the source of every field
+// @Tags         Races
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string  true  "Race ID (UUID)"
+// @Param        subraceID  path      string  true  "Subrace ID (UUID)"
+// @Success      200        {object}  models.EffectiveRace
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Router       /races/{id}/subraces/{subraceID}/effective [get]
+func (c *raceControllerGin) GetEffectiveRace(ctx *gin.Context) {
+	raceID, subraceID, err := parseSubracePath(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	effective, err := c.service.ResolveEffectiveRace(raceID, subraceID)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, effective)
+}
+
+// ResolveAbilityBonuses godoc
+// @Summary      Resolve ability bonuses
+// @Description  Combine the fixed ability bonuses of a race and, optionally, one of its subraces with the player's answers to their ability bonus choices
+// @Tags         Races
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string                        true  "Race ID (UUID)"
+// @Param        selection  body      models.AbilityBonusSelection  true  "Subrace and picks"
+// @Success      200        {object}  models.ResolvedAbilityBonuses
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Router       /races/{id}/ability-bonuses [post]
+func (c *raceControllerGin) ResolveAbilityBonuses(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var selection models.AbilityBonusSelection
+	if err := ctx.ShouldBindJSON(&selection); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	bonuses, err := c.service.ResolveAbilityBonuses(id, &selection)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, bonuses)
+}
+
+// racePageLinks builds the RFC 8288 links to the pages around page, keeping the other query parameters of the
+// request. Cursor pages only link forward and back to the first page.
+func racePageLinks(requestURL *url.URL, page *models.RacePage) []string {
+	link := func(rel string, set map[string]string) string {
+		query := requestURL.Query()
+		query.Del("offset")
+		query.Del("cursor")
+		for key, value := range set {
+			query.Set(key, value)
+		}
+		target := url.URL{Path: requestURL.Path, RawQuery: query.Encode()}
+		return fmt.Sprintf("<%s>; rel=\"%s\"", target.String(), rel)
+	}
+
+	limit := page.Query.Limit
+	links := []string{link("first", nil)}
+	if page.Query.Cursor != nil {
+		if page.NextCursor != "" {
+			links = append(links, link("next", map[string]string{"cursor": page.NextCursor}))
+		}
+		return links
+	}
+
+	offset := page.Query.Offset
+	if offset > 0 {
+		links = append(links, link("prev", map[string]string{"offset": strconv.Itoa(max(offset-limit, 0))}))
+	}
+	if page.NextCursor != "" {
+		links = append(links, link("next", map[string]string{"offset": strconv.Itoa(offset + limit)}))
+	}
+	if page.Total > 0 {
+		last := (int(page.Total) - 1) / limit * limit
+		links = append(links, link("last", map[string]string{"offset": strconv.Itoa(last)}))
+	}
+	return links
+}
@@ -0,0 +1,12 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SubraceController interface {
+	GetSubraces(ctx *gin.Context)
+	GetSubraceByID(ctx *gin.Context)
+	UpdateSubrace(ctx *gin.Context)
+	PatchSubrace(ctx *gin.Context)
+}
@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// subraceControllerGin is a concrete implementation of SubraceController using the Gin framework.
+type subraceControllerGin struct {
+	service services.SubraceService
+}
+
+// NewSubraceControllerGin creates a new instance of subraceControllerGin.
+func NewSubraceControllerGin(service services.SubraceService) SubraceController {
+	return &subraceControllerGin{
+		service: service,
+	}
+}
+
+// GetSubraces godoc
+// @Summary      List subraces
+// @Description  Return every subrace of a race with its traits, proficiencies and languages
+// @Tags         Subraces
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Race ID (UUID)"
+// @Success      200  {array}   models.Subrace
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /races/{id}/subraces [get]
+func (c *subraceControllerGin) GetSubraces(ctx *gin.Context) {
+	raceID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	subraces, err := c.service.ListSubraces(raceID)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, subraces)
+}
+
+// GetSubraceByID godoc
+// @Summary      Get subrace by ID
+// @Description  Retrieve a subrace of a race using the provided IDs
+// @Tags         Subraces
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string  true  "Race ID (UUID)"
+// @Param        subraceID  path      string  true  "Subrace ID (UUID)"
+// @Success      200        {object}  models.Subrace
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Router       /races/{id}/subraces/{subraceID} [get]
+func (c *subraceControllerGin) GetSubraceByID(ctx *gin.Context) {
+	raceID, subraceID, err := parseSubracePath(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	subrace, err := c.service.GetSubraceDetails(raceID, subraceID)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, subrace)
+}
+
+// UpdateSubrace godoc
+// @Summary      Update subrace
+// @Description  Replace a subrace's details, traits, proficiencies and languages
+// @Tags         Subraces
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string          true  "Race ID (UUID)"
+// @Param        subraceID  path      string          true  "Subrace ID (UUID)"
+// @Param        subrace    body      models.Subrace  true  "Subrace info"
+// @Success      200        {object}  models.Subrace
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Router       /races/{id}/subraces/{subraceID} [put]
+func (c *subraceControllerGin) UpdateSubrace(ctx *gin.Context) {
+	raceID, subraceID, err := parseSubracePath(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var subrace models.Subrace
+	if err := ctx.ShouldBindJSON(&subrace); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateSubraceInfo(raceID, subraceID, &subrace); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, subrace)
+}
+
+// PatchSubrace godoc
+// @Summary      Patch subrace
+// @Description  Update only the subrace fields present in the request body
+// @Tags         Subraces
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string               true  "Race ID (UUID)"
+// @Param        subraceID  path      string               true  "Subrace ID (UUID)"
+// @Param        patch      body      models.SubracePatch  true  "Subrace fields to update"
+// @Success      200        {object}  models.Subrace
+// @Failure      400        {object}  httperror.ErrorResponse
+// @Failure      404        {object}  httperror.ErrorResponse
+// @Failure      409        {object}  httperror.ErrorResponse
+// @Router       /races/{id}/subraces/{subraceID} [patch]
+func (c *subraceControllerGin) PatchSubrace(ctx *gin.Context) {
+	raceID, subraceID, err := parseSubracePath(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var patch models.SubracePatch
+	if err := ctx.ShouldBindJSON(&patch); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	subrace, err := c.service.PatchSubraceInfo(raceID, subraceID, &patch)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, subrace)
+}
+
+// parseSubracePath reads the race and subrace ID path parameters.
+func parseSubracePath(ctx *gin.Context) (uuid.UUID, uuid.UUID, error) {
+	raceID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %w", err))
+	}
+	subraceID, err := uuid.Parse(ctx.Param("subraceID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid subrace ID: %w", err))
+	}
+	return raceID, subraceID, nil
+}
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// AbilityBonusChoice is a bonus the player assigns, such as "+1 to two abilities of your choice": Count distinct
+// abilities are picked among From (every ability when empty) minus Exclude, and each is raised by Amount.
+type AbilityBonusChoice struct {
+	Count   int      `json:"count" example:"2"`
+	Amount  int      `json:"amount" example:"1"`
+	From    []string `json:"from,omitempty"`
+	Exclude []string `json:"exclude,omitempty" example:"charisma"`
+}
+
+// SourcedAbilityBonusChoice is a choice offered by a race or a subrace, identified by its index in that list.
+type SourcedAbilityBonusChoice struct {
+	AbilityBonusChoice
+	Source string `json:"source" enums:"race,subrace"`
+	Index  int    `json:"index"`
+}
+
+// AbilityBonusPick answers the choice at Index of the race's or subrace's ability bonus choices.
+type AbilityBonusPick struct {
+	Source    string   `json:"source" binding:"required" enums:"race,subrace"`
+	Index     int      `json:"index"`
+	Abilities []string `json:"abilities" binding:"required"`
+}
+
+// AbilityBonusSelection is the player's answer to every ability bonus choice of a race and, optionally, its subrace.
+type AbilityBonusSelection struct {
+	SubraceID *uuid.UUID         `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	Picks     []AbilityBonusPick `json:"picks"`
+}
+
+// ResolvedAbilityBonuses are the fixed and chosen ability bonuses of a race, merged with its subrace when one is given.
+type ResolvedAbilityBonuses struct {
+	RaceID              uuid.UUID               `json:"race_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubraceID           *uuid.UUID              `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	AbilityScoreBonuses []EffectiveAbilityBonus `json:"ability_score_bonuses"`
+}
+
+// Options returns the abilities the choice can be spent on, in canonical order.
+func (c AbilityBonusChoice) Options() []string {
+	options := []string{}
+	for _, ability := range Abilities {
+		if len(c.From) > 0 && !slices.Contains(c.From, ability) {
+			continue
+		}
+		if slices.Contains(c.Exclude, ability) {
+			continue
+		}
+		options = append(options, ability)
+	}
+	return options
+}
+
+// sourcedChoices lists the choices offered by the race and the subrace, which may be nil.
+func sourcedChoices(race *Race, subrace *Subrace) []SourcedAbilityBonusChoice {
+	choices := []SourcedAbilityBonusChoice{}
+	for i, choice := range race.AbilityBonusChoices {
+		choices = append(choices, SourcedAbilityBonusChoice{AbilityBonusChoice: choice, Source: SourceRace, Index: i})
+	}
+	if subrace != nil {
+		for i, choice := range subrace.AbilityBonusChoices {
+			choices = append(choices, SourcedAbilityBonusChoice{AbilityBonusChoice: choice, Source: SourceSubrace, Index: i})
+		}
+	}
+	return choices
+}
+
+// fixedAbilityBonuses lists the fixed bonus of every ability granted by the race and the subrace, which may be nil.
+func fixedAbilityBonuses(race *Race, subrace *Subrace) []EffectiveAbilityBonus {
+	bonuses := make([]EffectiveAbilityBonus, 0, len(Abilities))
+	for _, ability := range Abilities {
+		bonus := EffectiveAbilityBonus{Ability: ability, Contributions: []BonusContribution{}}
+		if value := race.AbilityScoreBonuses.Get(ability); value != 0 {
+			bonus.Contributions = append(bonus.Contributions, BonusContribution{Source: SourceRace, Value: value})
+			bonus.Total += value
+		}
+		if subrace != nil {
+			if value := subrace.AbilityScoreBonuses.Get(ability); value != 0 {
+				bonus.Contributions = append(bonus.Contributions, BonusContribution{Source: SourceSubrace, Value: value})
+				bonus.Total += value
+			}
+		}
+		bonuses = append(bonuses, bonus)
+	}
+	return bonuses
+}
+
+// ResolveAbilityBonuses adds the player's picks to the fixed bonuses of the race and the subrace, which may be nil.
+// Every choice must be answered exactly once with Count distinct abilities among its options.
+func ResolveAbilityBonuses(race *Race, subrace *Subrace, picks []AbilityBonusPick) ([]EffectiveAbilityBonus, error) {
+	bonuses := fixedAbilityBonuses(race, subrace)
+	choices := sourcedChoices(race, subrace)
+
+	answered := make(map[string]bool)
+	for _, pick := range picks {
+		key := fmt.Sprintf("%s:%d", pick.Source, pick.Index)
+		if answered[key] {
+			return nil, fmt.Errorf("%s ability bonus choice %d answered more than once", pick.Source, pick.Index)
+		}
+		answered[key] = true
+
+		i := slices.IndexFunc(choices, func(choice SourcedAbilityBonusChoice) bool {
+			return choice.Source == pick.Source && choice.Index == pick.Index
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("%s has no ability bonus choice %d", pick.Source, pick.Index)
+		}
+		choice := choices[i]
+
+		if len(pick.Abilities) != choice.Count {
+			return nil, fmt.Errorf("%s ability bonus choice %d requires %d abilities, got %d", pick.Source, pick.Index, choice.Count, len(pick.Abilities))
+		}
+		options := choice.Options()
+		for j, ability := range pick.Abilities {
+			if !slices.Contains(options, ability) {
+				return nil, fmt.Errorf("%s ability bonus choice %d cannot be spent on %s, options are %v", pick.Source, pick.Index, ability, options)
+			}
+			if slices.Contains(pick.Abilities[:j], ability) {
+				return nil, fmt.Errorf("%s ability bonus choice %d picks %s more than once", pick.Source, pick.Index, ability)
+			}
+
+			bonus := &bonuses[slices.Index(Abilities, ability)]
+			bonus.Contributions = append(bonus.Contributions, BonusContribution{Source: pick.Source, Value: choice.Amount, Choice: true})
+			bonus.Total += choice.Amount
+		}
+	}
+
+	for _, choice := range choices {
+		if !answered[fmt.Sprintf("%s:%d", choice.Source, choice.Index)] {
+			return nil, fmt.Errorf("%s ability bonus choice %d has not been answered", choice.Source, choice.Index)
+		}
+	}
+	return bonuses, nil
+}
@@ -0,0 +1,44 @@
+package models
+
+// RaceTarget is implemented by entities that receive a race's features, such as characters.
+type RaceTarget interface {
+	ApplyAbilityBonus(ability string, bonus int)
+	SetSize(size string)
+	SetSpeed(speed int8)
+	AddLanguage(language Language)
+	AddProficiency(proficiency Proficiency)
+}
+
+// ApplyTo grants the race's ability score bonuses, size, speed, languages and proficiencies to target.
+func (r *Race) ApplyTo(target RaceTarget) {
+	for _, ability := range Abilities {
+		if bonus := r.AbilityScoreBonuses.Get(ability); bonus != 0 {
+			target.ApplyAbilityBonus(ability, bonus)
+		}
+	}
+	target.SetSize(r.Size)
+	target.SetSpeed(r.Speed)
+	for _, lang := range r.LanguagesKnown {
+		target.AddLanguage(lang)
+	}
+	for _, prof := range r.Proficiencies {
+		target.AddProficiency(prof)
+	}
+}
+
+// ApplyTo grants the merged race and subrace features to target.
+func (e *EffectiveRace) ApplyTo(target RaceTarget) {
+	for _, bonus := range e.AbilityScoreBonuses {
+		if bonus.Total != 0 {
+			target.ApplyAbilityBonus(bonus.Ability, bonus.Total)
+		}
+	}
+	target.SetSize(e.Size.Value)
+	target.SetSpeed(e.Speed.Value)
+	for _, lang := range e.LanguagesKnown {
+		target.AddLanguage(lang.Language)
+	}
+	for _, prof := range e.Proficiencies {
+		target.AddProficiency(prof.Proficiency)
+	}
+}
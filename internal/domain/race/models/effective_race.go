@@ -0,0 +1,179 @@
+package models
+
+import "github.com/google/uuid"
+
+const (
+	SourceRace    = "race"
+	SourceSubrace = "subrace"
+)
+
+// EffectiveRace is the profile obtained by applying a subrace on top of its parent race.
+// Every field records which of the two it came from.
+type EffectiveRace struct {
+	RaceID              uuid.UUID                   `json:"race_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubraceID           uuid.UUID                   `json:"subrace_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name                EffectiveString             `json:"name"`
+	Description         []EffectiveString           `json:"description"`
+	Size                EffectiveString             `json:"size"`
+	Speed               EffectiveSpeed              `json:"speed"`
+	Alignment           EffectiveString             `json:"alignment"`
+	Age                 EffectiveAge                `json:"age"`
+	AbilityScoreBonuses []EffectiveAbilityBonus     `json:"ability_score_bonuses"`
+	AbilityBonusChoices []SourcedAbilityBonusChoice `json:"ability_bonus_choices"`
+	Proficiencies       []EffectiveProficiency      `json:"proficiencies"`
+	LanguagesKnown      []EffectiveLanguage         `json:"languages_known"`
+	Traits              []EffectiveTrait            `json:"traits"`
+}
+
+type EffectiveString struct {
+	Value  string `json:"value"`
+	Source string `json:"source" enums:"race,subrace"`
+}
+
+type EffectiveSpeed struct {
+	Value  int8   `json:"value"`
+	Source string `json:"source" enums:"race,subrace"`
+}
+
+type EffectiveAge struct {
+	Age
+	Source string `json:"source" enums:"race"`
+}
+
+// BonusContribution is the part of an ability bonus granted by a single source. Choice marks a bonus the player picked.
+type BonusContribution struct {
+	Source string `json:"source" enums:"race,subrace"`
+	Value  int    `json:"value"`
+	Choice bool   `json:"choice,omitempty"`
+}
+
+type EffectiveAbilityBonus struct {
+	Ability       string              `json:"ability" example:"dexterity"`
+	Total         int                 `json:"total"`
+	Contributions []BonusContribution `json:"contributions"`
+}
+
+type EffectiveProficiency struct {
+	Proficiency
+	Sources []string `json:"sources"`
+}
+
+type EffectiveLanguage struct {
+	Language
+	Sources []string `json:"sources"`
+}
+
+type EffectiveTrait struct {
+	Trait
+	Sources []string `json:"sources"`
+}
+
+// Get returns the bonus for the named ability, or 0 when the name is unknown.
+func (b AbilityScoreBonuses) Get(ability string) int {
+	switch ability {
+	case AbilityStrength:
+		return b.Strength
+	case AbilityDexterity:
+		return b.Dexterity
+	case AbilityConstitution:
+		return b.Constitution
+	case AbilityIntelligence:
+		return b.Intelligence
+	case AbilityWisdom:
+		return b.Wisdom
+	case AbilityCharisma:
+		return b.Charisma
+	default:
+		return 0
+	}
+}
+
+// ResolveEffectiveRace merges a race with one of its subraces. Fixed ability bonuses are summed, bonus choices
+// are listed for the player to answer, traits, proficiencies and languages are unioned, and a non-zero subrace speed overrides the race speed.
+func ResolveEffectiveRace(race *Race, subrace *Subrace) *EffectiveRace {
+	effective := &EffectiveRace{
+		RaceID:    race.ID,
+		SubraceID: subrace.ID,
+		Name:      EffectiveString{Value: subrace.Name, Source: SourceSubrace},
+		Size:      EffectiveString{Value: race.Size, Source: SourceRace},
+		Speed:     EffectiveSpeed{Value: race.Speed, Source: SourceRace},
+		Alignment: EffectiveString{Value: race.Alignment, Source: SourceRace},
+		Age:       EffectiveAge{Age: race.Age, Source: SourceRace},
+	}
+
+	if race.Description != "" {
+		effective.Description = append(effective.Description, EffectiveString{Value: race.Description, Source: SourceRace})
+	}
+	if subrace.Description != "" {
+		effective.Description = append(effective.Description, EffectiveString{Value: subrace.Description, Source: SourceSubrace})
+	}
+
+	if subrace.Speed > 0 {
+		effective.Speed = EffectiveSpeed{Value: subrace.Speed, Source: SourceSubrace}
+	}
+
+	effective.AbilityScoreBonuses = fixedAbilityBonuses(race, subrace)
+	effective.AbilityBonusChoices = sourcedChoices(race, subrace)
+
+	effective.Proficiencies = []EffectiveProficiency{}
+	for _, prof := range race.Proficiencies {
+		effective.Proficiencies = append(effective.Proficiencies, EffectiveProficiency{Proficiency: prof, Sources: []string{SourceRace}})
+	}
+	for _, prof := range subrace.Proficiencies {
+		if i := indexOf(len(effective.Proficiencies), func(i int) bool {
+			return sameEntry(effective.Proficiencies[i].ID, prof.ID, effective.Proficiencies[i].Name, prof.Name)
+		}); i >= 0 {
+			effective.Proficiencies[i].Sources = append(effective.Proficiencies[i].Sources, SourceSubrace)
+			continue
+		}
+		effective.Proficiencies = append(effective.Proficiencies, EffectiveProficiency{Proficiency: prof, Sources: []string{SourceSubrace}})
+	}
+
+	effective.LanguagesKnown = []EffectiveLanguage{}
+	for _, lang := range race.LanguagesKnown {
+		effective.LanguagesKnown = append(effective.LanguagesKnown, EffectiveLanguage{Language: lang, Sources: []string{SourceRace}})
+	}
+	for _, lang := range subrace.LanguagesKnown {
+		if i := indexOf(len(effective.LanguagesKnown), func(i int) bool {
+			return sameEntry(effective.LanguagesKnown[i].ID, lang.ID, effective.LanguagesKnown[i].Name, lang.Name)
+		}); i >= 0 {
+			effective.LanguagesKnown[i].Sources = append(effective.LanguagesKnown[i].Sources, SourceSubrace)
+			continue
+		}
+		effective.LanguagesKnown = append(effective.LanguagesKnown, EffectiveLanguage{Language: lang, Sources: []string{SourceSubrace}})
+	}
+
+	effective.Traits = []EffectiveTrait{}
+	for _, trait := range race.Traits {
+		effective.Traits = append(effective.Traits, EffectiveTrait{Trait: trait, Sources: []string{SourceRace}})
+	}
+	for _, trait := range subrace.Traits {
+		if i := indexOf(len(effective.Traits), func(i int) bool {
+			return sameEntry(effective.Traits[i].ID, trait.ID, effective.Traits[i].Name, trait.Name)
+		}); i >= 0 {
+			effective.Traits[i].Sources = append(effective.Traits[i].Sources, SourceSubrace)
+			continue
+		}
+		effective.Traits = append(effective.Traits, EffectiveTrait{Trait: trait, Sources: []string{SourceSubrace}})
+	}
+
+	return effective
+}
+
+// indexOf returns the first index below n satisfying match, or -1.
+func indexOf(n int, match func(i int) bool) int {
+	for i := 0; i < n; i++ {
+		if match(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameEntry reports whether two catalog entries are the same, by ID when both have one and by name otherwise.
+func sameEntry(idA, idB uuid.UUID, nameA, nameB string) bool {
+	if idA != uuid.Nil && idB != uuid.Nil {
+		return idA == idB
+	}
+	return nameA == nameB
+}
@@ -2,7 +2,22 @@ package models
 
 import "github.com/google/uuid"
 
+const (
+	LanguageTypeStandard = "standard"
+	LanguageTypeExotic   = "exotic"
+)
+
 type Language struct {
-	ID   uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name string    `json:"name" gorm:"unique;not null"`
+	ID              uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name            string    `json:"name" gorm:"unique;not null"`
+	Type            string    `json:"type,omitempty" gorm:"not null;default:standard" enums:"standard,exotic" example:"standard"`
+	Script          string    `json:"script,omitempty" example:"Elvish"`
+	TypicalSpeakers []string  `json:"typical_speakers,omitempty" gorm:"type:jsonb;serializer:json"`
+}
+
+// LanguageSpeakers lists the races and subraces that know a language.
+type LanguageSpeakers struct {
+	Language *Language  `json:"language"`
+	Races    []*Race    `json:"races"`
+	Subraces []*Subrace `json:"subraces"`
 }
@@ -2,8 +2,37 @@ package models
 
 import "github.com/google/uuid"
 
+const (
+	ProficiencyCategorySkill       = "skill"
+	ProficiencyCategoryTool        = "tool"
+	ProficiencyCategoryWeapon      = "weapon"
+	ProficiencyCategoryArmor       = "armor"
+	ProficiencyCategorySavingThrow = "saving_throw"
+)
+
+const (
+	AbilityStrength     = "strength"
+	AbilityDexterity    = "dexterity"
+	AbilityConstitution = "constitution"
+	AbilityIntelligence = "intelligence"
+	AbilityWisdom       = "wisdom"
+	AbilityCharisma     = "charisma"
+)
+
+// Abilities lists the six ability names in their canonical order.
+var Abilities = []string{
+	AbilityStrength,
+	AbilityDexterity,
+	AbilityConstitution,
+	AbilityIntelligence,
+	AbilityWisdom,
+	AbilityCharisma,
+}
+
 type Proficiency struct {
 	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
 	Name        string    `json:"name" gorm:"unique;not null"`
 	Description string    `json:"description"`
+	Category    string    `json:"category,omitempty" gorm:"index" enums:"skill,tool,weapon,armor,saving_throw" example:"skill"`
+	Ability     string    `json:"ability,omitempty" enums:"strength,dexterity,constitution,intelligence,wisdom,charisma" example:"dexterity"`
 }
@@ -0,0 +1,182 @@
+package models
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultRaceListLimit = 20
+	MaxRaceListLimit     = 100
+)
+
+// RaceSortFields are the fields races can be sorted by.
+var RaceSortFields = []string{"name", "size", "speed", "alignment"}
+
+// RaceFields are the fields a sparse fieldset can select, named as in the JSON of a race.
+var RaceFields = []string{
+	"id", "name", "description", "ability_score_bonuses", "ability_bonus_choices", "age", "size", "speed", "alignment",
+	"proficiencies", "languages_known", "traits", "subraces",
+}
+
+// RaceIncludes are the associations of a race that can be loaded with it. Subraces come with their own
+// proficiencies, languages and traits.
+var RaceIncludes = []string{"age", "proficiencies", "languages_known", "traits", "subraces"}
+
+// SortField is one key of a sort, such as -speed for the fastest first.
+type SortField struct {
+	Field      string
+	Descending bool
+}
+
+// RaceListQuery selects one page of races. Cursor continues after the last race of a previous page sorted the same
+// way and excludes Offset. Include lists the associations to load, every one unless an include or a sparse fieldset
+// was requested, and Fields the fields to return, every field that was loaded when empty.
+type RaceListQuery struct {
+	Limit   int
+	Offset  int
+	Cursor  *RaceCursor
+	Sort    []SortField
+	Fields  []string
+	Include []string
+}
+
+// RaceCursor marks the last race of a page by the values of its sort keys and its ID, which breaks ties.
+type RaceCursor struct {
+	Sort   string    `json:"s"`
+	Values []any     `json:"v"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// RacePage is one page of races with the total number of races and the cursor of the following page, empty on the
+// last page.
+type RacePage struct {
+	Races      []*Race
+	Total      int64
+	NextCursor string
+	Query      *RaceListQuery
+}
+
+// SortString formats the sort as accepted by the sort query parameter.
+func (q *RaceListQuery) SortString() string {
+	keys := make([]string, len(q.Sort))
+	for i, key := range q.Sort {
+		keys[i] = key.Field
+		if key.Descending {
+			keys[i] = "-" + key.Field
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// Includes reports whether the association should be loaded. Associations selected by the sparse fieldset are
+// loaded even when they are not included explicitly.
+func (q *RaceListQuery) Includes(association string) bool {
+	return slices.Contains(q.Include, association) || slices.Contains(q.Fields, association)
+}
+
+// SelectedFields returns the fields of each race in the response: the sparse fieldset when one was requested,
+// otherwise every field except the associations that were not loaded.
+func (q *RaceListQuery) SelectedFields() []string {
+	if len(q.Fields) > 0 {
+		return q.Fields
+	}
+
+	fields := make([]string, 0, len(RaceFields))
+	for _, field := range RaceFields {
+		if slices.Contains(RaceIncludes, field) && !q.Includes(field) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// NewRaceCursor creates the cursor continuing after race in the order of the query.
+func NewRaceCursor(query *RaceListQuery, race *Race) *RaceCursor {
+	cursor := &RaceCursor{Sort: query.SortString(), ID: race.ID}
+	for _, key := range query.Sort {
+		cursor.Values = append(cursor.Values, race.sortValue(key.Field))
+	}
+	return cursor
+}
+
+// Encode returns the cursor as an opaque URL-safe string.
+func (c *RaceCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeRaceCursor parses a cursor returned by Encode and checks that it was created for the given sort.
+func DecodeRaceCursor(value string, query *RaceListQuery) (*RaceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("malformed cursor")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var cursor RaceCursor
+	if err := decoder.Decode(&cursor); err != nil {
+		return nil, errors.New("malformed cursor")
+	}
+	if cursor.Sort != query.SortString() {
+		return nil, fmt.Errorf("cursor was created for sort '%s', not '%s'", cursor.Sort, query.SortString())
+	}
+	if len(cursor.Values) != len(query.Sort) {
+		return nil, errors.New("malformed cursor")
+	}
+
+	for i, key := range query.Sort {
+		switch value := cursor.Values[i].(type) {
+		case json.Number:
+			speed, err := value.Int64()
+			if err != nil || key.Field != "speed" {
+				return nil, errors.New("malformed cursor")
+			}
+			cursor.Values[i] = speed
+		case string:
+			if key.Field == "speed" {
+				return nil, errors.New("malformed cursor")
+			}
+		default:
+			return nil, errors.New("malformed cursor")
+		}
+	}
+	return &cursor, nil
+}
+
+// Project returns the race as a JSON object holding only the given fields.
+func (r *Race) Project(fields []string) map[string]json.RawMessage {
+	data, _ := json.Marshal(r)
+	var all map[string]json.RawMessage
+	_ = json.Unmarshal(data, &all)
+
+	projected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			projected[field] = value
+		}
+	}
+	return projected
+}
+
+func (r *Race) sortValue(field string) any {
+	switch field {
+	case "size":
+		return r.Size
+	case "speed":
+		return int64(r.Speed)
+	case "alignment":
+		return r.Alignment
+	default:
+		return r.Name
+	}
+}
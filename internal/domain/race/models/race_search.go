@@ -0,0 +1,61 @@
+package models
+
+// Operators of race search filters, written as field[operator]=value. A filter without an operator uses eq.
+const (
+	OperatorEq    = "eq"
+	OperatorNe    = "ne"
+	OperatorGt    = "gt"
+	OperatorGte   = "gte"
+	OperatorLt    = "lt"
+	OperatorLte   = "lte"
+	OperatorLike  = "like"
+	OperatorILike = "ilike"
+	OperatorIn    = "in"
+)
+
+var (
+	textOperators    = []string{OperatorEq, OperatorNe, OperatorLike, OperatorILike, OperatorIn}
+	numericOperators = []string{OperatorEq, OperatorNe, OperatorGt, OperatorGte, OperatorLt, OperatorLte, OperatorIn}
+)
+
+// RaceSearchField is a field races can be searched by and the operators it accepts. Numeric fields compare numbers
+// and the others text.
+type RaceSearchField struct {
+	Name      string
+	Numeric   bool
+	Operators []string
+}
+
+// RaceSearchFields lists the fields races can be searched by. trait, language, proficiency and subrace match the
+// names of the associated entities, so ne finds the races without a match.
+var RaceSearchFields = []RaceSearchField{
+	{Name: "name", Operators: textOperators},
+	{Name: "description", Operators: []string{OperatorLike, OperatorILike}},
+	{Name: "size", Operators: textOperators},
+	{Name: "alignment", Operators: textOperators},
+	{Name: "speed", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.strength", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.dexterity", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.constitution", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.intelligence", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.wisdom", Numeric: true, Operators: numericOperators},
+	{Name: "bonus.charisma", Numeric: true, Operators: numericOperators},
+	{Name: "trait", Operators: textOperators},
+	{Name: "language", Operators: textOperators},
+	{Name: "proficiency", Operators: textOperators},
+	{Name: "subrace", Operators: textOperators},
+}
+
+// RaceFilter compares one field with the given values. Only the in operator takes more than one value. Values of
+// numeric fields are int64, the others string.
+type RaceFilter struct {
+	Field    string
+	Operator string
+	Values   []any
+}
+
+// RaceSearch selects the races matching every filter and at least one filter of each OR group.
+type RaceSearch struct {
+	Filters []RaceFilter
+	Groups  [][]RaceFilter
+}
@@ -3,9 +3,54 @@ package models
 import "github.com/google/uuid"
 
 type Subrace struct {
-	ID                  uuid.UUID           `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	RaceID              uuid.UUID           `json:"race_id" gorm:"type:uuid;foreignKey:RaceID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name                string              `json:"name" gorm:"not null"`
-	Description         string              `json:"description"`
-	AbilityScoreBonuses AbilityScoreBonuses `json:"ability_score_bonuses" gorm:"embedded"`
+	ID                  uuid.UUID            `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RaceID              uuid.UUID            `json:"race_id" gorm:"type:uuid;foreignKey:RaceID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name                string               `json:"name" gorm:"not null"`
+	Description         string               `json:"description"`
+	AbilityScoreBonuses AbilityScoreBonuses  `json:"ability_score_bonuses" gorm:"embedded"`
+	AbilityBonusChoices []AbilityBonusChoice `json:"ability_bonus_choices,omitempty" gorm:"type:jsonb;serializer:json"`
+	Speed               int8                 `json:"speed,omitempty"`
+	Proficiencies       []Proficiency        `json:"proficiencies,omitempty" gorm:"many2many:subrace_proficiencies;constraint:OnDelete:CASCADE;"`
+	LanguagesKnown      []Language           `json:"languages_known,omitempty" gorm:"many2many:subrace_languages;constraint:OnDelete:CASCADE;"`
+	Traits              []Trait              `json:"traits,omitempty" gorm:"many2many:subrace_traits;constraint:OnDelete:CASCADE;"`
+}
+
+// SubracePatch holds a partial subrace update. Nil fields are left untouched.
+type SubracePatch struct {
+	Name                *string               `json:"name,omitempty"`
+	Description         *string               `json:"description,omitempty"`
+	AbilityScoreBonuses *AbilityScoreBonuses  `json:"ability_score_bonuses,omitempty"`
+	AbilityBonusChoices *[]AbilityBonusChoice `json:"ability_bonus_choices,omitempty"`
+	Speed               *int8                 `json:"speed,omitempty"`
+	Proficiencies       *[]Proficiency        `json:"proficiencies,omitempty"`
+	LanguagesKnown      *[]Language           `json:"languages_known,omitempty"`
+	Traits              *[]Trait              `json:"traits,omitempty"`
+}
+
+// Apply copies every field set in the patch onto the subrace.
+func (p *SubracePatch) Apply(subrace *Subrace) {
+	if p.Name != nil {
+		subrace.Name = *p.Name
+	}
+	if p.Description != nil {
+		subrace.Description = *p.Description
+	}
+	if p.AbilityScoreBonuses != nil {
+		subrace.AbilityScoreBonuses = *p.AbilityScoreBonuses
+	}
+	if p.AbilityBonusChoices != nil {
+		subrace.AbilityBonusChoices = *p.AbilityBonusChoices
+	}
+	if p.Speed != nil {
+		subrace.Speed = *p.Speed
+	}
+	if p.Proficiencies != nil {
+		subrace.Proficiencies = *p.Proficiencies
+	}
+	if p.LanguagesKnown != nil {
+		subrace.LanguagesKnown = *p.LanguagesKnown
+	}
+	if p.Traits != nil {
+		subrace.Traits = *p.Traits
+	}
 }
@@ -1,9 +1,58 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Recharge rules of limited-use resources.
+const (
+	RechargeShortRest = "short_rest"
+	RechargeLongRest  = "long_rest"
+	RechargeDawn      = "dawn"
+	RechargeRoll      = "recharge_roll"
+)
+
+// Recharges lists the valid recharge rules.
+var Recharges = []string{RechargeShortRest, RechargeLongRest, RechargeDawn, RechargeRoll}
+
+// DefaultRechargeOn is the lowest d6 result recharging a recharge_roll resource, as in "Recharge 5–6".
+const DefaultRechargeOn = 5
 
 type Trait struct {
-	ID          uuid.UUID `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name        string    `json:"name" gorm:"unique;not null"`
-	Description string    `json:"description"`
+	ID          uuid.UUID   `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string      `json:"name" gorm:"unique;not null"`
+	Description string      `json:"description"`
+	LimitedUse  *LimitedUse `json:"limited_use,omitempty" gorm:"type:jsonb;serializer:json"`
+}
+
+// LimitedUse tags a trait, such as a breath weapon, as a resource that can only be used a number of times before
+// it recharges.
+type LimitedUse struct {
+	Uses       int    `json:"uses" example:"1"`
+	Recharge   string `json:"recharge" enums:"short_rest,long_rest,dawn,recharge_roll" example:"short_rest"`
+	RechargeOn int    `json:"recharge_on,omitempty" example:"5"`
+}
+
+// Validate checks the uses and recharge rule, defaulting RechargeOn for recharge_roll resources.
+func (l *LimitedUse) Validate() error {
+	if l.Uses < 1 {
+		return fmt.Errorf("limited use must allow at least one use: %d", l.Uses)
+	}
+	if !slices.Contains(Recharges, l.Recharge) {
+		return fmt.Errorf("invalid recharge rule: %s", l.Recharge)
+	}
+	if l.Recharge != RechargeRoll {
+		l.RechargeOn = 0
+		return nil
+	}
+	if l.RechargeOn == 0 {
+		l.RechargeOn = DefaultRechargeOn
+	}
+	if l.RechargeOn < 2 || l.RechargeOn > 6 {
+		return fmt.Errorf("recharge roll must succeed on a d6 result between 2 and 6: %d", l.RechargeOn)
+	}
+	return nil
 }
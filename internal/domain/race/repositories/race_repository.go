@@ -6,15 +6,19 @@ import (
 )
 
 type RaceRepository interface {
-	GetAllRaces() ([]*models.Race, error)
+	GetAllRaces(query *models.RaceListQuery) ([]*models.Race, int64, error)
 	GetRaceByID(id uuid.UUID) (*models.Race, error)
 	GetRaceByName(name string) (*models.Race, error)
 	CreateRace(race *models.Race) error
 	UpdateRace(id uuid.UUID, race *models.Race) error
+	PatchRace(id uuid.UUID, race *models.Race, fields []string) error
 	DeleteRace(id uuid.UUID) error
 	AddSubrace(raceID uuid.UUID, subrace *models.Subrace) error
 	RemoveSubrace(raceID uuid.UUID, subraceID uuid.UUID) error
 	AddTrait(raceID uuid.UUID, traitID uuid.UUID) error
 	RemoveTrait(raceID uuid.UUID, traitID uuid.UUID) error
-	SearchRaces(criteria map[string]string) ([]models.Race, error)
+	SearchRaces(search *models.RaceSearch) ([]models.Race, error)
+	GetProficiency(id uuid.UUID, name string) (*models.Proficiency, error)
+	GetLanguage(id uuid.UUID) (*models.Language, error)
+	GetTrait(id uuid.UUID) (*models.Trait, error)
 }
@@ -3,10 +3,14 @@ package repositories
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // raceRepositoryGormImpl is a concrete implementation of the RaceRepository interface using GORM.
@@ -21,18 +25,61 @@ func NewGormRaceRepository(db *gorm.DB) RaceRepository {
 	}
 }
 
-// GetAllRaces retrieves all races from the database, including their related entities.
-func (r *raceRepositoryGormImpl) GetAllRaces() ([]*models.Race, error) {
+// GetAllRaces retrieves one page of races in the order of the query, loading only the associations it includes, and
+// the total number of races. One race beyond the limit is fetched so the caller can tell whether another page follows.
+func (r *raceRepositoryGormImpl) GetAllRaces(query *models.RaceListQuery) ([]*models.Race, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Race{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	db := r.db
+	if query.Includes("age") {
+		db = db.Preload("Age")
+	}
+	if query.Includes("proficiencies") {
+		db = db.Preload("Proficiencies")
+	}
+	if query.Includes("languages_known") {
+		db = db.Preload("LanguagesKnown")
+	}
+	if query.Includes("traits") {
+		db = db.Preload("Traits")
+	}
+	if query.Includes("subraces") {
+		db = db.Preload("Subraces").
+			Preload("Subraces.Proficiencies").
+			Preload("Subraces.LanguagesKnown").
+			Preload("Subraces.Traits")
+	}
+
+	for _, key := range query.Sort {
+		column, err := raceSortColumn(key.Field)
+		if err != nil {
+			return nil, 0, err
+		}
+		if key.Descending {
+			column += " DESC"
+		}
+		db = db.Order(column)
+	}
+	db = db.Order("id")
+
+	if query.Cursor != nil {
+		condition, args, err := raceKeysetCondition(query.Sort, query.Cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+		db = db.Where(condition, args...)
+	} else {
+		db = db.Offset(query.Offset)
+	}
+
 	var races []*models.Race
-	if err := r.db.Preload("Proficiencies").
-		Preload("LanguagesKnown").
-		Preload("Traits").
-		Preload("Subraces").
-		Preload("Age").
-		Find(&races).Error; err != nil {
-		return nil, err
+	if err := db.Limit(query.Limit + 1).Find(&races).Error; err != nil {
+		return nil, 0, err
 	}
-	return races, nil
+	return races, total, nil
 }
 
 // GetRaceByID retrieves a race by its ID, including its related entities.
@@ -42,11 +89,14 @@ func (r *raceRepositoryGormImpl) GetRaceByID(id uuid.UUID) (*models.Race, error)
 		Preload("LanguagesKnown").
 		Preload("Traits").
 		Preload("Subraces").
+		Preload("Subraces.Proficiencies").
+		Preload("Subraces.LanguagesKnown").
+		Preload("Subraces.Traits").
 		Preload("Age").
 		First(&race, "id = ?", id).Error; err != nil {
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("race with ID %s not found", id.String())
+			return nil, fmt.Errorf("race with ID %s %w", id.String(), failure.ErrorNotFound)
 		}
 		return nil, err
 	}
@@ -60,6 +110,9 @@ func (r *raceRepositoryGormImpl) GetRaceByName(name string) (*models.Race, error
 		Preload("LanguagesKnown").
 		Preload("Traits").
 		Preload("Subraces").
+		Preload("Subraces.Proficiencies").
+		Preload("Subraces.LanguagesKnown").
+		Preload("Subraces.Traits").
 		Preload("Age").
 		Where("name = ?", name).
 		First(&race).Error; err != nil {
@@ -129,6 +182,7 @@ func (r *raceRepositoryGormImpl) UpdateRace(id uuid.UUID, race *models.Race) err
 	existingRace.Speed = race.Speed
 	existingRace.Alignment = race.Alignment
 	existingRace.AbilityScoreBonuses = race.AbilityScoreBonuses
+	existingRace.AbilityBonusChoices = race.AbilityBonusChoices
 
 	if err := tx.Model(&existingRace).Association("Age").Replace(&race.Age); err != nil {
 		tx.Rollback()
@@ -163,6 +217,113 @@ func (r *raceRepositoryGormImpl) UpdateRace(id uuid.UUID, race *models.Race) err
 	return tx.Commit().Error
 }
 
+// PatchRace updates the given fields of a race, named as in its JSON, leaving the other fields and associations
+// untouched. Patched subraces are matched by ID: known ones are updated, new ones created and missing ones deleted.
+func (r *raceRepositoryGormImpl) PatchRace(id uuid.UUID, race *models.Race, fields []string) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingRace models.Race
+	if err := tx.First(&existingRace, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("race with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	var columns []string
+	for _, field := range fields {
+		var err error
+		switch field {
+		case "name", "description", "size", "speed", "alignment", "ability_bonus_choices":
+			columns = append(columns, field)
+		case "ability_score_bonuses":
+			columns = append(columns, "strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma")
+		case "age":
+			race.Age.RaceID = id
+			if err = tx.Where("race_id = ?", id).Delete(&models.Age{}).Error; err == nil {
+				err = tx.Create(&race.Age).Error
+			}
+		case "proficiencies":
+			err = tx.Model(&existingRace).Association("Proficiencies").Replace(race.Proficiencies)
+		case "languages_known":
+			err = tx.Model(&existingRace).Association("LanguagesKnown").Replace(race.LanguagesKnown)
+		case "traits":
+			err = tx.Model(&existingRace).Association("Traits").Replace(race.Traits)
+		case "subraces":
+			err = patchSubraces(tx, id, race.Subraces)
+		default:
+			err = fmt.Errorf("race field %s cannot be patched", field)
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(columns) > 0 {
+		if err := tx.Model(&existingRace).Select(columns).Omit(clause.Associations).Updates(race).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// patchSubraces makes subraces the subraces of a race, updating those that exist, creating the others and deleting
+// the subraces of the race that are not listed.
+func patchSubraces(tx *gorm.DB, raceID uuid.UUID, subraces []models.Subrace) error {
+	var existingIDs []uuid.UUID
+	if err := tx.Model(&models.Subrace{}).Where("race_id = ?", raceID).Pluck("id", &existingIDs).Error; err != nil {
+		return err
+	}
+
+	keptIDs := make([]uuid.UUID, 0, len(subraces))
+	for i := range subraces {
+		subrace := &subraces[i]
+		subrace.RaceID = raceID
+
+		if subrace.ID == uuid.Nil || !slices.Contains(existingIDs, subrace.ID) {
+			if err := tx.Create(subrace).Error; err != nil {
+				return err
+			}
+			keptIDs = append(keptIDs, subrace.ID)
+			continue
+		}
+
+		columns := []string{"name", "description", "strength", "dexterity", "constitution", "intelligence", "wisdom", "charisma", "ability_bonus_choices", "speed"}
+		if err := tx.Model(subrace).Select(columns).Omit(clause.Associations).Updates(subrace).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(subrace).Association("Proficiencies").Replace(subrace.Proficiencies); err != nil {
+			return err
+		}
+		if err := tx.Model(subrace).Association("LanguagesKnown").Replace(subrace.LanguagesKnown); err != nil {
+			return err
+		}
+		if err := tx.Model(subrace).Association("Traits").Replace(subrace.Traits); err != nil {
+			return err
+		}
+		keptIDs = append(keptIDs, subrace.ID)
+	}
+
+	query := tx.Where("race_id = ?", raceID)
+	if len(keptIDs) > 0 {
+		query = query.Where("id NOT IN ?", keptIDs)
+	}
+	return query.Delete(&models.Subrace{}).Error
+}
+
 // DeleteRace removes a race from the database.
 func (r *raceRepositoryGormImpl) DeleteRace(id uuid.UUID) error {
 	tx := r.db.Begin()
@@ -270,31 +431,214 @@ func (r *raceRepositoryGormImpl) RemoveTrait(raceID uuid.UUID, traitID uuid.UUID
 	return r.db.Model(&race).Association("Traits").Delete(&trait)
 }
 
-// SearchRaces allows searching for races based on specific criteria.
-func (r *raceRepositoryGormImpl) SearchRaces(criteria map[string]string) ([]models.Race, error) {
-	var races []models.Race
+// SearchRaces retrieves the races matching a search, ordered by name, including their related entities.
+func (r *raceRepositoryGormImpl) SearchRaces(search *models.RaceSearch) ([]models.Race, error) {
 	query := r.db.Preload("Proficiencies").
 		Preload("LanguagesKnown").
 		Preload("Traits").
 		Preload("Subraces").
+		Preload("Subraces.Proficiencies").
+		Preload("Subraces.LanguagesKnown").
+		Preload("Subraces.Traits").
 		Preload("Age")
 
-	for key, value := range criteria {
-		switch key {
-		case "size":
-			query = query.Where("size = ?", value)
-		case "speed":
-			query = query.Where("speed = ?", value)
-		case "alignment":
-			query = query.Where("alignment = ?", value)
-		default:
-			return nil, fmt.Errorf("unknown search criteria: %s", key)
+	for _, filter := range search.Filters {
+		condition, args, err := raceFilterCondition(filter)
+		if err != nil {
+			return nil, err
 		}
+		query = query.Where(condition, args...)
 	}
 
-	if err := query.Find(&races).Error; err != nil {
+	for _, group := range search.Groups {
+		var conditions []string
+		var args []any
+		for _, filter := range group {
+			condition, filterArgs, err := raceFilterCondition(filter)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, "("+condition+")")
+			args = append(args, filterArgs...)
+		}
+		query = query.Where(strings.Join(conditions, " OR "), args...)
+	}
+
+	var races []models.Race
+	if err := query.Order("races.name").Find(&races).Error; err != nil {
 		return nil, err
 	}
 
 	return races, nil
 }
+
+// GetProficiency retrieves a proficiency of the catalog by its ID or, when the ID is nil, by its exact name.
+func (r *raceRepositoryGormImpl) GetProficiency(id uuid.UUID, name string) (*models.Proficiency, error) {
+	return findProficiency(r.db, id, name)
+}
+
+// GetLanguage retrieves a language of the catalog by its ID.
+func (r *raceRepositoryGormImpl) GetLanguage(id uuid.UUID) (*models.Language, error) {
+	var language models.Language
+	if err := r.db.First(&language, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("language with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &language, nil
+}
+
+// GetTrait retrieves a trait of the catalog by its ID.
+func (r *raceRepositoryGormImpl) GetTrait(id uuid.UUID) (*models.Trait, error) {
+	var trait models.Trait
+	if err := r.db.First(&trait, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("trait with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &trait, nil
+}
+
+// raceAssociationSearches maps the association search fields to the subquery finding the matching related names of
+// a race. The condition on the name is appended to the subquery.
+var raceAssociationSearches = map[string]string{
+	"trait":       "SELECT 1 FROM race_traits JOIN traits ON traits.id = race_traits.trait_id WHERE race_traits.race_id = races.id AND traits.name",
+	"language":    "SELECT 1 FROM race_languages JOIN languages ON languages.id = race_languages.language_id WHERE race_languages.race_id = races.id AND languages.name",
+	"proficiency": "SELECT 1 FROM race_proficiencies JOIN proficiencies ON proficiencies.id = race_proficiencies.proficiency_id WHERE race_proficiencies.race_id = races.id AND proficiencies.name",
+	"subrace":     "SELECT 1 FROM subraces WHERE subraces.race_id = races.id AND subraces.name",
+}
+
+// raceFilterCondition translates a search filter to a SQL condition on races. Association filters become EXISTS
+// subqueries, negated for ne.
+func raceFilterCondition(filter models.RaceFilter) (string, []any, error) {
+	if subquery, ok := raceAssociationSearches[filter.Field]; ok {
+		if filter.Operator == models.OperatorNe {
+			return "NOT EXISTS (" + subquery + " = ?)", filter.Values, nil
+		}
+		comparison, args, err := raceComparison(filter.Operator, filter.Values)
+		if err != nil {
+			return "", nil, err
+		}
+		return "EXISTS (" + subquery + comparison + ")", args, nil
+	}
+
+	var column string
+	switch filter.Field {
+	case "name", "description", "size", "alignment", "speed":
+		column = "races." + filter.Field
+	case "bonus.strength", "bonus.dexterity", "bonus.constitution", "bonus.intelligence", "bonus.wisdom", "bonus.charisma":
+		column = "races." + strings.TrimPrefix(filter.Field, "bonus.")
+	default:
+		return "", nil, fmt.Errorf("unsupported search field: %s", filter.Field)
+	}
+
+	comparison, args, err := raceComparison(filter.Operator, filter.Values)
+	if err != nil {
+		return "", nil, err
+	}
+	return column + comparison, args, nil
+}
+
+// raceComparison returns the comparison of a filter, to be appended to the compared expression. like and ilike
+// match the value anywhere in the text.
+func raceComparison(operator string, values []any) (string, []any, error) {
+	if len(values) == 0 {
+		return "", nil, fmt.Errorf("no value for operator %s", operator)
+	}
+
+	switch operator {
+	case models.OperatorEq:
+		return " = ?", values[:1], nil
+	case models.OperatorNe:
+		return " <> ?", values[:1], nil
+	case models.OperatorGt:
+		return " > ?", values[:1], nil
+	case models.OperatorGte:
+		return " >= ?", values[:1], nil
+	case models.OperatorLt:
+		return " < ?", values[:1], nil
+	case models.OperatorLte:
+		return " <= ?", values[:1], nil
+	case models.OperatorLike, models.OperatorILike:
+		pattern := "%" + likeEscaper.Replace(fmt.Sprint(values[0])) + "%"
+		return " " + strings.ToUpper(operator) + " ?", []any{pattern}, nil
+	case models.OperatorIn:
+		return " IN ?", []any{values}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported search operator: %s", operator)
+	}
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns, whose default escape character is the backslash.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// raceSortColumn returns the expression a sort field orders by. Optional columns are coalesced to the zero value
+// the race is read with, so races without them sort together and the cursor values compare with them.
+func raceSortColumn(field string) (string, error) {
+	switch field {
+	case "name":
+		return field, nil
+	case "size", "alignment":
+		return "COALESCE(" + field + ", '')", nil
+	case "speed":
+		return "COALESCE(speed, 0)", nil
+	default:
+		return "", fmt.Errorf("unsupported sort field: %s", field)
+	}
+}
+
+// raceKeysetCondition selects the races sorted after the cursor: those past it on the first sort key, or tied on
+// the first keys and past it on the next one, with the ID breaking the final tie.
+func raceKeysetCondition(sort []models.SortField, cursor *models.RaceCursor) (string, []any, error) {
+	var conditions []string
+	var args []any
+	for i := 0; i <= len(sort); i++ {
+		var parts []string
+		for j := 0; j < i; j++ {
+			column, err := raceSortColumn(sort[j].Field)
+			if err != nil {
+				return "", nil, err
+			}
+			parts = append(parts, column+" = ?")
+			args = append(args, cursor.Values[j])
+		}
+
+		if i < len(sort) {
+			column, err := raceSortColumn(sort[i].Field)
+			if err != nil {
+				return "", nil, err
+			}
+			operator := " > ?"
+			if sort[i].Descending {
+				operator = " < ?"
+			}
+			parts = append(parts, column+operator)
+			args = append(args, cursor.Values[i])
+		} else {
+			parts = append(parts, "id > ?")
+			args = append(args, cursor.ID)
+		}
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+	return strings.Join(conditions, " OR "), args, nil
+}
+
+// findProficiency looks up a catalog proficiency for the race and subrace repositories, by ID when one is given and
+// by exact name otherwise.
+func findProficiency(db *gorm.DB, id uuid.UUID, name string) (*models.Proficiency, error) {
+	var proficiency models.Proficiency
+	query, reference := db.Where("name = ?", name), "'"+name+"'"
+	if id != uuid.Nil {
+		query, reference = db.Where("id = ?", id), "with ID "+id.String()
+	}
+
+	if err := query.First(&proficiency).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("proficiency %s %w", reference, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &proficiency, nil
+}
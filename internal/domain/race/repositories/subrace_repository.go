@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type SubraceRepository interface {
+	GetSubracesByRace(raceID uuid.UUID) ([]*models.Subrace, error)
+	GetSubraceByID(raceID uuid.UUID, subraceID uuid.UUID) (*models.Subrace, error)
+	UpdateSubrace(raceID uuid.UUID, subraceID uuid.UUID, subrace *models.Subrace) error
+	GetProficiency(id uuid.UUID, name string) (*models.Proficiency, error)
+}
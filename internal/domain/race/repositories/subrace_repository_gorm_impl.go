@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// subraceRepositoryGormImpl is a concrete implementation of the SubraceRepository interface using GORM.
+type subraceRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormSubraceRepository creates a new instance of subraceRepositoryGormImpl.
+func NewGormSubraceRepository(db *gorm.DB) SubraceRepository {
+	return &subraceRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetSubracesByRace retrieves all subraces of a race, including their related entities.
+func (r *subraceRepositoryGormImpl) GetSubracesByRace(raceID uuid.UUID) ([]*models.Subrace, error) {
+	var race models.Race
+	if err := r.db.Select("id").First(&race, "id = ?", raceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("race with ID %s %w", raceID.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+
+	var subraces []*models.Subrace
+	if err := r.db.Preload("Proficiencies").
+		Preload("LanguagesKnown").
+		Preload("Traits").
+		Where("race_id = ?", raceID).
+		Order("name").
+		Find(&subraces).Error; err != nil {
+		return nil, err
+	}
+	return subraces, nil
+}
+
+// GetSubraceByID retrieves a subrace of a race, including its related entities.
+func (r *subraceRepositoryGormImpl) GetSubraceByID(raceID uuid.UUID, subraceID uuid.UUID) (*models.Subrace, error) {
+	var subrace models.Subrace
+	if err := r.db.Preload("Proficiencies").
+		Preload("LanguagesKnown").
+		Preload("Traits").
+		Where("id = ? AND race_id = ?", subraceID, raceID).
+		First(&subrace).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("subrace with ID %s for race ID %s %w", subraceID.String(), raceID.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &subrace, nil
+}
+
+// UpdateSubrace replaces a subrace's details and associations in the database.
+func (r *subraceRepositoryGormImpl) UpdateSubrace(raceID uuid.UUID, subraceID uuid.UUID, subrace *models.Subrace) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingSubrace models.Subrace
+	if err := tx.Where("id = ? AND race_id = ?", subraceID, raceID).First(&existingSubrace).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("subrace with ID %s for race ID %s %w", subraceID.String(), raceID.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	existingSubrace.Name = subrace.Name
+	existingSubrace.Description = subrace.Description
+	existingSubrace.AbilityScoreBonuses = subrace.AbilityScoreBonuses
+	existingSubrace.AbilityBonusChoices = subrace.AbilityBonusChoices
+	existingSubrace.Speed = subrace.Speed
+
+	if err := tx.Model(&existingSubrace).Association("Proficiencies").Replace(subrace.Proficiencies); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingSubrace).Association("LanguagesKnown").Replace(subrace.LanguagesKnown); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&existingSubrace).Association("Traits").Replace(subrace.Traits); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Omit("Proficiencies", "LanguagesKnown", "Traits").Save(&existingSubrace).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	subrace.ID = existingSubrace.ID
+	subrace.RaceID = existingSubrace.RaceID
+	return nil
+}
+
+// GetProficiency retrieves a proficiency of the catalog by its ID or, when the ID is nil, by its exact name.
+func (r *subraceRepositoryGormImpl) GetProficiency(id uuid.UUID, name string) (*models.Proficiency, error) {
+	return findProficiency(r.db, id, name)
+}
@@ -6,14 +6,17 @@ import (
 )
 
 type RaceService interface {
-	ListRaces() ([]*models.Race, error)
+	ListRaces(params map[string]string) (*models.RacePage, error)
 	GetRaceDetails(id uuid.UUID) (*models.Race, error)
 	RegisterRace(race *models.Race) error
 	UpdateRaceInfo(id uuid.UUID, race *models.Race) error
+	PatchRace(id uuid.UUID, contentType string, patch []byte) (*models.Race, error)
 	RemoveRace(id uuid.UUID) error
 	AddSubraceToRace(raceID uuid.UUID, subrace *models.Subrace) error
 	DetachSubraceFromRace(raceID uuid.UUID, subraceID uuid.UUID) error
 	AssignTraitToRace(raceID uuid.UUID, traitID uuid.UUID) error
 	UnassignTraitFromRace(raceID uuid.UUID, traitID uuid.UUID) error
 	FindRaces(criteria map[string]string) ([]models.Race, error)
+	ResolveEffectiveRace(raceID uuid.UUID, subraceID uuid.UUID) (*models.EffectiveRace, error)
+	ResolveAbilityBonuses(raceID uuid.UUID, selection *models.AbilityBonusSelection) (*models.ResolvedAbilityBonuses, error)
 }
@@ -1,15 +1,26 @@
 package services
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/repositories"
 	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/jsonpatch"
 	"github.com/google/uuid"
 )
 
+// maxAbilityBonus bounds racial ability bonuses in both directions. Negative bonuses are allowed for homebrew races.
+const maxAbilityBonus = 10
+
 // raceServiceImpl is the concrete implementation of RaceService.
 type raceServiceImpl struct {
 	repo repositories.RaceRepository
@@ -22,12 +33,23 @@ func NewRaceService(repo repositories.RaceRepository) RaceService {
 	}
 }
 
-func (s *raceServiceImpl) ListRaces() ([]*models.Race, error) {
-	races, err := s.repo.GetAllRaces()
+func (s *raceServiceImpl) ListRaces(params map[string]string) (*models.RacePage, error) {
+	query, err := parseRaceListQuery(params)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	races, total, err := s.repo.GetAllRaces(query)
 	if err != nil {
 		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list races: %w", err))
 	}
-	return races, nil
+
+	page := &models.RacePage{Races: races, Total: total, Query: query}
+	if len(races) > query.Limit {
+		page.Races = races[:query.Limit]
+		page.NextCursor = models.NewRaceCursor(query, page.Races[query.Limit-1]).Encode()
+	}
+	return page, nil
 }
 
 func (s *raceServiceImpl) GetRaceDetails(id uuid.UUID) (*models.Race, error) {
@@ -46,6 +68,9 @@ func (s *raceServiceImpl) RegisterRace(race *models.Race) error {
 	if err := validateRace(race); err != nil {
 		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race data: %w", err))
 	}
+	if err := s.resolveRaceProficiencies(race); err != nil {
+		return err
+	}
 
 	existingRace, _ := s.repo.GetRaceByName(race.Name)
 	if existingRace != nil {
@@ -66,6 +91,9 @@ func (s *raceServiceImpl) UpdateRaceInfo(id uuid.UUID, race *models.Race) error
 	if err := validateRace(race); err != nil {
 		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race data: %w", err))
 	}
+	if err := s.resolveRaceProficiencies(race); err != nil {
+		return err
+	}
 
 	existingRace, err := s.repo.GetRaceByID(id)
 	if err != nil {
@@ -85,6 +113,148 @@ func (s *raceServiceImpl) UpdateRaceInfo(id uuid.UUID, race *models.Race) error
 	return nil
 }
 
+func (s *raceServiceImpl) PatchRace(id uuid.UUID, contentType string, patch []byte) (*models.Race, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %s", id.String()))
+	}
+
+	race, err := s.repo.GetRaceByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get race details by ID: %w", err))
+	}
+
+	original, err := json.Marshal(race)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to encode race: %w", err))
+	}
+
+	var document []byte
+	switch contentType {
+	case jsonpatch.MergePatchContentType, "application/json":
+		document, err = jsonpatch.MergePatch(original, patch)
+	case jsonpatch.JSONPatchContentType:
+		document, err = jsonpatch.Apply(original, patch)
+	default:
+		return nil, failure.NewError(failure.ErrorUnsupportedMediaType, fmt.Errorf("unsupported patch content type '%s', use %s or %s", contentType, jsonpatch.MergePatchContentType, jsonpatch.JSONPatchContentType))
+	}
+	if err != nil {
+		kind := failure.ErrorBadRequest
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			kind = failure.ErrorConflict
+		}
+		return nil, failure.NewError(kind, fmt.Errorf("failed to apply patch: %w", err))
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.DisallowUnknownFields()
+	var patched models.Race
+	if err := decoder.Decode(&patched); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid patched race: %w", err))
+	}
+
+	if patched.ID != race.ID {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("the race ID cannot be changed"))
+	}
+	for _, subrace := range patched.Subraces {
+		known := slices.ContainsFunc(race.Subraces, func(existing models.Subrace) bool { return existing.ID == subrace.ID })
+		if subrace.ID != uuid.Nil && !known {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("subrace with ID %s does not belong to the race, omit the ID to add a subrace", subrace.ID.String()))
+		}
+	}
+
+	if err := s.resolvePatchedLinks(&patched); err != nil {
+		return nil, err
+	}
+	if err := validateRace(&patched); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race data: %w", err))
+	}
+	if err := s.resolveRaceProficiencies(&patched); err != nil {
+		return nil, err
+	}
+
+	if patched.Name != race.Name {
+		duplicateRace, _ := s.repo.GetRaceByName(patched.Name)
+		if duplicateRace != nil {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("race with name '%s' already exists", patched.Name))
+		}
+	}
+
+	fields, err := changedRaceFields(race, &patched)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to compare races: %w", err))
+	}
+	if len(fields) == 0 {
+		return race, nil
+	}
+
+	if err := s.repo.PatchRace(id, &patched, fields); err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to patch race: %w", err))
+	}
+
+	updated, err := s.repo.GetRaceByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get patched race: %w", err))
+	}
+	return updated, nil
+}
+
+func (s *raceServiceImpl) resolveRaceProficiencies(race *models.Race) error {
+	if err := resolveProficiencies(s.repo.GetProficiency, race.Proficiencies); err != nil {
+		return err
+	}
+	for i := range race.Subraces {
+		if err := resolveProficiencies(s.repo.GetProficiency, race.Subraces[i].Proficiencies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolvePatchedLinks replaces the languages and traits of a patched race and its subraces with their catalog
+// entries. A race only stores links to them, so a patch must link them by ID and cannot change their fields, which
+// would otherwise be dropped on save.
+func (s *raceServiceImpl) resolvePatchedLinks(race *models.Race) error {
+	languages := [][]models.Language{race.LanguagesKnown}
+	traits := [][]models.Trait{race.Traits}
+	for _, subrace := range race.Subraces {
+		languages = append(languages, subrace.LanguagesKnown)
+		traits = append(traits, subrace.Traits)
+	}
+
+	for _, list := range languages {
+		for i, language := range list {
+			if language.ID == uuid.Nil {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("language '%s' needs the ID of a catalog language, create new languages through /languages", language.Name))
+			}
+			entry, err := s.repo.GetLanguage(language.ID)
+			if err != nil {
+				return catalogLinkError("language", language.ID, err)
+			}
+			if linkDiffers(language, entry) {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("language '%s' differs from the catalog, edit it through /languages", entry.Name))
+			}
+			list[i] = *entry
+		}
+	}
+
+	for _, list := range traits {
+		for i, trait := range list {
+			if trait.ID == uuid.Nil {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("trait '%s' needs the ID of a catalog trait, create new traits through /traits", trait.Name))
+			}
+			entry, err := s.repo.GetTrait(trait.ID)
+			if err != nil {
+				return catalogLinkError("trait", trait.ID, err)
+			}
+			if linkDiffers(trait, entry) {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("trait '%s' differs from the catalog, edit it through /traits", entry.Name))
+			}
+			list[i] = *entry
+		}
+	}
+	return nil
+}
+
 func (s *raceServiceImpl) RemoveRace(id uuid.UUID) error {
 	if id == uuid.Nil {
 		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %s", id.String()))
@@ -112,6 +282,9 @@ func (s *raceServiceImpl) AddSubraceToRace(raceID uuid.UUID, subrace *models.Sub
 	if err := validateSubrace(subrace); err != nil {
 		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid subrace data: %w", err))
 	}
+	if err := resolveProficiencies(s.repo.GetProficiency, subrace.Proficiencies); err != nil {
+		return err
+	}
 
 	if err := s.repo.AddSubrace(raceID, subrace); err != nil {
 		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to add subrace to race: %w", err))
@@ -121,7 +294,7 @@ func (s *raceServiceImpl) AddSubraceToRace(raceID uuid.UUID, subrace *models.Sub
 
 func (s *raceServiceImpl) DetachSubraceFromRace(raceID uuid.UUID, subraceID uuid.UUID) error {
 	if raceID == uuid.Nil || subraceID == uuid.Nil {
-		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or subrace ID: raceID=%d, subraceID=%d", raceID.String(), subraceID.String()))
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or subrace ID: raceID=%s, subraceID=%s", raceID.String(), subraceID.String()))
 	}
 
 	if err := s.repo.RemoveSubrace(raceID, subraceID); err != nil {
@@ -132,7 +305,7 @@ func (s *raceServiceImpl) DetachSubraceFromRace(raceID uuid.UUID, subraceID uuid
 
 func (s *raceServiceImpl) AssignTraitToRace(raceID uuid.UUID, traitID uuid.UUID) error {
 	if raceID == uuid.Nil || traitID == uuid.Nil {
-		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or trait ID: raceID=%d, traitID=%d", raceID.String(), traitID.String()))
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or trait ID: raceID=%s, traitID=%s", raceID.String(), traitID.String()))
 	}
 
 	if err := s.repo.AddTrait(raceID, traitID); err != nil {
@@ -143,7 +316,7 @@ func (s *raceServiceImpl) AssignTraitToRace(raceID uuid.UUID, traitID uuid.UUID)
 
 func (s *raceServiceImpl) UnassignTraitFromRace(raceID uuid.UUID, traitID uuid.UUID) error {
 	if raceID == uuid.Nil || traitID == uuid.Nil {
-		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or trait ID: raceID=%d, traitID=%d", raceID.String(), traitID.String()))
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or trait ID: raceID=%s, traitID=%s", raceID.String(), traitID.String()))
 	}
 
 	if err := s.repo.RemoveTrait(raceID, traitID); err != nil {
@@ -157,13 +330,70 @@ func (s *raceServiceImpl) FindRaces(criteria map[string]string) ([]models.Race,
 		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("no search criteria provided"))
 	}
 
-	races, err := s.repo.SearchRaces(criteria)
+	search, err := parseRaceSearch(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	races, err := s.repo.SearchRaces(search)
 	if err != nil {
 		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to find races: %w", err))
 	}
 	return races, nil
 }
 
+func (s *raceServiceImpl) ResolveEffectiveRace(raceID uuid.UUID, subraceID uuid.UUID) (*models.EffectiveRace, error) {
+	if raceID == uuid.Nil || subraceID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or subrace ID: raceID=%s, subraceID=%s", raceID.String(), subraceID.String()))
+	}
+
+	race, err := s.repo.GetRaceByID(raceID)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to resolve effective race: %w", err))
+	}
+
+	for i := range race.Subraces {
+		if race.Subraces[i].ID == subraceID {
+			return models.ResolveEffectiveRace(race, &race.Subraces[i]), nil
+		}
+	}
+	return nil, failure.NewError(failure.ErrorNotFound, fmt.Errorf("subrace with ID %s not found for race ID %s", subraceID.String(), raceID.String()))
+}
+
+func (s *raceServiceImpl) ResolveAbilityBonuses(raceID uuid.UUID, selection *models.AbilityBonusSelection) (*models.ResolvedAbilityBonuses, error) {
+	if raceID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %s", raceID.String()))
+	}
+
+	race, err := s.repo.GetRaceByID(raceID)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to resolve ability bonuses: %w", err))
+	}
+
+	var subrace *models.Subrace
+	if selection.SubraceID != nil {
+		for i := range race.Subraces {
+			if race.Subraces[i].ID == *selection.SubraceID {
+				subrace = &race.Subraces[i]
+			}
+		}
+		if subrace == nil {
+			return nil, failure.NewError(failure.ErrorNotFound, fmt.Errorf("subrace with ID %s not found for race ID %s", selection.SubraceID.String(), raceID.String()))
+		}
+	}
+
+	bonuses, err := models.ResolveAbilityBonuses(race, subrace, selection.Picks)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	return &models.ResolvedAbilityBonuses{
+		RaceID:              race.ID,
+		SubraceID:           selection.SubraceID,
+		AbilityScoreBonuses: bonuses,
+	}, nil
+}
+
 func validateRace(race *models.Race) error {
 	if race.Name == "" {
 		return failure.NewError(failure.ErrorBadRequest, errors.New("race name cannot be empty"))
@@ -184,6 +414,10 @@ func validateRace(race *models.Race) error {
 		return failure.NewError(failure.ErrorBadRequest, err)
 	}
 
+	if err := validateAbilityBonusChoices(race.AbilityBonusChoices); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, err)
+	}
+
 	if err := validateAge(&race.Age); err != nil {
 		return failure.NewError(failure.ErrorBadRequest, err)
 	}
@@ -218,32 +452,36 @@ func validateRace(race *models.Race) error {
 func validateAbilityBonus(bonus *models.AbilityScoreBonuses) error {
 	var errMsgs []string
 
-	if bonus.Strength < 0 {
-		errMsgs = append(errMsgs, "Strength bonus cannot be negative")
-	}
-	if bonus.Dexterity < 0 {
-		errMsgs = append(errMsgs, "Dexterity bonus cannot be negative")
-	}
-	if bonus.Constitution < 0 {
-		errMsgs = append(errMsgs, "Constitution bonus cannot be negative")
-	}
-	if bonus.Intelligence < 0 {
-		errMsgs = append(errMsgs, "Intelligence bonus cannot be negative")
-	}
-	if bonus.Wisdom < 0 {
-		errMsgs = append(errMsgs, "Wisdom bonus cannot be negative")
-	}
-	if bonus.Charisma < 0 {
-		errMsgs = append(errMsgs, "Charisma bonus cannot be negative")
+	for _, ability := range models.Abilities {
+		if value := bonus.Get(ability); value < -maxAbilityBonus || value > maxAbilityBonus {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s bonus must be between %d and %d: %d", ability, -maxAbilityBonus, maxAbilityBonus, value))
+		}
 	}
 
 	if len(errMsgs) > 0 {
-		return fmt.Errorf("negative ability bonuses: %v", errMsgs)
+		return fmt.Errorf("invalid ability bonuses: %v", errMsgs)
 	}
 
 	return nil
 }
 
+func validateAbilityBonusChoices(choices []models.AbilityBonusChoice) error {
+	for i, choice := range choices {
+		for _, ability := range append(slices.Clone(choice.From), choice.Exclude...) {
+			if !slices.Contains(models.Abilities, ability) {
+				return fmt.Errorf("ability bonus choice %d: invalid ability %s", i, ability)
+			}
+		}
+		if choice.Amount == 0 || choice.Amount < -maxAbilityBonus || choice.Amount > maxAbilityBonus {
+			return fmt.Errorf("ability bonus choice %d: amount must be between %d and %d and not zero: %d", i, -maxAbilityBonus, maxAbilityBonus, choice.Amount)
+		}
+		if options := choice.Options(); choice.Count < 1 || choice.Count > len(options) {
+			return fmt.Errorf("ability bonus choice %d: count must be between 1 and the %d available abilities: %d", i, len(options), choice.Count)
+		}
+	}
+	return nil
+}
+
 func validateAge(age *models.Age) error {
 	if age.AverageLifespan == "" {
 		return errors.New("average lifespan cannot be empty")
@@ -258,8 +496,65 @@ func validateAge(age *models.Age) error {
 }
 
 func validateProficiency(prof *models.Proficiency) error {
-	if prof.Name == "" {
-		return errors.New("proficiency name cannot be empty")
+	if prof.ID == uuid.Nil && prof.Name == "" {
+		return errors.New("proficiency needs the ID or the name of a catalog proficiency")
+	}
+	return nil
+}
+
+// linkDiffers reports whether a link to a catalog entry sets any field, named as in the JSON of the entry, to another
+// value than the catalog. Fields left empty are taken from the catalog.
+func linkDiffers(link any, entry any) bool {
+	given, stored := jsonFields(link), jsonFields(entry)
+	for field, value := range given {
+		if value == nil || value == "" || value == 0.0 || value == false {
+			continue
+		}
+		if !reflect.DeepEqual(value, stored[field]) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields returns the fields of a value as encoded in JSON.
+func jsonFields(value any) map[string]any {
+	fields := make(map[string]any)
+	data, _ := json.Marshal(value)
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// catalogLinkError turns a failed lookup of a linked catalog entry into a bad request when the entry does not exist.
+func catalogLinkError(entity string, id uuid.UUID, err error) error {
+	if errors.Is(err, failure.ErrorNotFound) {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s with ID %s does not exist", entity, id.String()))
+	}
+	return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to look up %s: %w", entity, err))
+}
+
+// resolveProficiencies replaces each proficiency with its catalog entry, found by ID or name. Races and subraces only
+// link catalog proficiencies, so unknown ones and ones differing from their entry are rejected.
+func resolveProficiencies(find func(uuid.UUID, string) (*models.Proficiency, error), proficiencies []models.Proficiency) error {
+	for i, prof := range proficiencies {
+		entry, err := find(prof.ID, prof.Name)
+		if err != nil {
+			if errors.Is(err, failure.ErrorNotFound) {
+				ref := fmt.Sprintf("'%s'", prof.Name)
+				if prof.ID != uuid.Nil {
+					ref = "with ID " + prof.ID.String()
+				}
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency %s does not exist, add it to the proficiency catalog first", ref))
+			}
+			return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to look up proficiency: %w", err))
+		}
+
+		differs := func(value, catalog string) bool { return value != "" && value != catalog }
+		if differs(prof.Name, entry.Name) || differs(prof.Description, entry.Description) ||
+			differs(prof.Category, entry.Category) || differs(prof.Ability, entry.Ability) {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency '%s' differs from the catalog, link it by ID or name and edit it through /proficiencies", entry.Name))
+		}
+		proficiencies[i] = *entry
 	}
 	return nil
 }
@@ -275,6 +570,11 @@ func validateTrait(trait *models.Trait) error {
 	if trait.Name == "" {
 		return errors.New("trait name cannot be empty")
 	}
+	if trait.LimitedUse != nil {
+		if err := trait.LimitedUse.Validate(); err != nil {
+			return fmt.Errorf("trait '%s': %w", trait.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -282,5 +582,241 @@ func validateSubrace(subrace *models.Subrace) error {
 	if subrace.Name == "" {
 		return errors.New("subrace name cannot be empty")
 	}
+	if subrace.Speed < 0 {
+		return fmt.Errorf("invalid subrace speed: %d", subrace.Speed)
+	}
+
+	if err := validateAbilityBonus(&subrace.AbilityScoreBonuses); err != nil {
+		return err
+	}
+
+	if err := validateAbilityBonusChoices(subrace.AbilityBonusChoices); err != nil {
+		return err
+	}
+
+	for _, prof := range subrace.Proficiencies {
+		if err := validateProficiency(&prof); err != nil {
+			return err
+		}
+	}
+
+	for _, lang := range subrace.LanguagesKnown {
+		if err := validateLanguage(&lang); err != nil {
+			return err
+		}
+	}
+
+	for _, trait := range subrace.Traits {
+		if err := validateTrait(&trait); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func parseRaceListQuery(params map[string]string) (*models.RaceListQuery, error) {
+	query := &models.RaceListQuery{
+		Limit: models.DefaultRaceListLimit,
+		Sort:  []models.SortField{{Field: "name"}},
+	}
+
+	for key, value := range params {
+		switch key {
+		case "limit":
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > models.MaxRaceListLimit {
+				return nil, fmt.Errorf("limit must be a number between 1 and %d: %s", models.MaxRaceListLimit, value)
+			}
+			query.Limit = limit
+		case "offset":
+			offset, err := strconv.Atoi(value)
+			if err != nil || offset < 0 {
+				return nil, fmt.Errorf("offset must be a non-negative number: %s", value)
+			}
+			query.Offset = offset
+		case "sort":
+			sort, err := parseRaceSort(value)
+			if err != nil {
+				return nil, err
+			}
+			query.Sort = sort
+		case "fields":
+			fields, err := parseRaceFieldList(value, "field", models.RaceFields)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(fields, "id") {
+				fields = append([]string{"id"}, fields...)
+			}
+			query.Fields = fields
+		case "include":
+			if strings.TrimSpace(value) == "" {
+				query.Include = []string{}
+				continue
+			}
+			include, err := parseRaceFieldList(value, "include", models.RaceIncludes)
+			if err != nil {
+				return nil, err
+			}
+			query.Include = include
+		case "cursor":
+		default:
+			return nil, fmt.Errorf("unknown parameter '%s', allowed parameters are limit, offset, cursor, sort, fields and include", key)
+		}
+	}
+
+	// Races were always listed with every association, so that stays the default of a plain listing.
+	if _, ok := params["include"]; !ok && len(query.Fields) == 0 {
+		query.Include = slices.Clone(models.RaceIncludes)
+	}
+
+	if value, ok := params["cursor"]; ok {
+		if _, ok := params["offset"]; ok {
+			return nil, errors.New("cursor and offset cannot be combined")
+		}
+		cursor, err := models.DecodeRaceCursor(value, query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		query.Cursor = cursor
+	}
+	return query, nil
+}
+
+func parseRaceSort(value string) ([]models.SortField, error) {
+	var sort []models.SortField
+	for _, key := range strings.Split(value, ",") {
+		field := models.SortField{Field: strings.TrimSpace(key)}
+		if strings.HasPrefix(field.Field, "-") {
+			field.Field = field.Field[1:]
+			field.Descending = true
+		}
+
+		if !slices.Contains(models.RaceSortFields, field.Field) {
+			return nil, fmt.Errorf("unknown sort field '%s', allowed sort fields are %s", field.Field, strings.Join(models.RaceSortFields, ", "))
+		}
+		if slices.ContainsFunc(sort, func(other models.SortField) bool { return other.Field == field.Field }) {
+			return nil, fmt.Errorf("duplicate sort field: %s", field.Field)
+		}
+		sort = append(sort, field)
+	}
+	return sort, nil
+}
+
+func parseRaceFieldList(value string, kind string, allowed []string) ([]string, error) {
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if !slices.Contains(allowed, field) {
+			return nil, fmt.Errorf("unknown %s '%s', allowed values are %s", kind, field, strings.Join(allowed, ", "))
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// raceSearchKey matches search parameters such as speed, speed[gte] and or.1.trait[ilike].
+var raceSearchKey = regexp.MustCompile(`^(?:or\.([a-z0-9_]+)\.)?([a-z_.]+)(?:\[([a-z]+)\])?$`)
+
+func parseRaceSearch(criteria map[string]string) (*models.RaceSearch, error) {
+	keys := make([]string, 0, len(criteria))
+	for key := range criteria {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	search := &models.RaceSearch{}
+	groups := make(map[string]int)
+	for _, key := range keys {
+		match := raceSearchKey.FindStringSubmatch(key)
+		if match == nil {
+			return nil, fmt.Errorf("invalid filter '%s', filters are written as field, field[operator] or or.<group>.field[operator]", key)
+		}
+
+		filter, err := parseRaceFilter(match[2], match[3], criteria[key])
+		if err != nil {
+			return nil, err
+		}
+
+		if match[1] == "" {
+			search.Filters = append(search.Filters, *filter)
+			continue
+		}
+		index, ok := groups[match[1]]
+		if !ok {
+			index = len(search.Groups)
+			groups[match[1]] = index
+			search.Groups = append(search.Groups, nil)
+		}
+		search.Groups[index] = append(search.Groups[index], *filter)
+	}
+	return search, nil
+}
+
+func parseRaceFilter(name string, operator string, value string) (*models.RaceFilter, error) {
+	index := slices.IndexFunc(models.RaceSearchFields, func(field models.RaceSearchField) bool { return field.Name == name })
+	if index < 0 {
+		names := make([]string, len(models.RaceSearchFields))
+		for i, field := range models.RaceSearchFields {
+			names[i] = field.Name
+		}
+		return nil, fmt.Errorf("unknown filter '%s', allowed filters are %s", name, strings.Join(names, ", "))
+	}
+	field := models.RaceSearchFields[index]
+
+	if operator == "" {
+		operator = models.OperatorEq
+	}
+	if !slices.Contains(field.Operators, operator) {
+		return nil, fmt.Errorf("operator '%s' is not allowed for %s, allowed operators are %s", operator, name, strings.Join(field.Operators, ", "))
+	}
+
+	values := []string{value}
+	if operator == models.OperatorIn {
+		values = strings.Split(value, ",")
+	}
+
+	filter := &models.RaceFilter{Field: name, Operator: operator}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("filter %s needs a value", name)
+		}
+		if !field.Numeric {
+			filter.Values = append(filter.Values, value)
+			continue
+		}
+
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("filter %s must be a whole number: %s", name, value)
+		}
+		filter.Values = append(filter.Values, number)
+	}
+	return filter, nil
+}
+
+// changedRaceFields lists the top-level JSON fields whose encoding differs between the two races.
+func changedRaceFields(before *models.Race, after *models.Race) ([]string, error) {
+	var encoded [2]map[string]json.RawMessage
+	for i, race := range []*models.Race{before, after} {
+		data, err := json.Marshal(race)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &encoded[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var fields []string
+	for _, field := range models.RaceFields {
+		if !bytes.Equal(encoded[0][field], encoded[1][field]) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type SubraceService interface {
+	ListSubraces(raceID uuid.UUID) ([]*models.Subrace, error)
+	GetSubraceDetails(raceID uuid.UUID, subraceID uuid.UUID) (*models.Subrace, error)
+	UpdateSubraceInfo(raceID uuid.UUID, subraceID uuid.UUID, subrace *models.Subrace) error
+	PatchSubraceInfo(raceID uuid.UUID, subraceID uuid.UUID, patch *models.SubracePatch) (*models.Subrace, error)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// subraceServiceImpl is the concrete implementation of SubraceService.
+type subraceServiceImpl struct {
+	repo repositories.SubraceRepository
+}
+
+// NewSubraceService creates a new instance of subraceServiceImpl.
+func NewSubraceService(repo repositories.SubraceRepository) SubraceService {
+	return &subraceServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *subraceServiceImpl) ListSubraces(raceID uuid.UUID) ([]*models.Subrace, error) {
+	if raceID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID: %s", raceID.String()))
+	}
+
+	subraces, err := s.repo.GetSubracesByRace(raceID)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to list subraces: %w", err))
+	}
+	return subraces, nil
+}
+
+func (s *subraceServiceImpl) GetSubraceDetails(raceID uuid.UUID, subraceID uuid.UUID) (*models.Subrace, error) {
+	if raceID == uuid.Nil || subraceID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or subrace ID: raceID=%s, subraceID=%s", raceID.String(), subraceID.String()))
+	}
+
+	subrace, err := s.repo.GetSubraceByID(raceID, subraceID)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get subrace details: %w", err))
+	}
+	return subrace, nil
+}
+
+func (s *subraceServiceImpl) UpdateSubraceInfo(raceID uuid.UUID, subraceID uuid.UUID, subrace *models.Subrace) error {
+	if raceID == uuid.Nil || subraceID == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid race ID or subrace ID: raceID=%s, subraceID=%s", raceID.String(), subraceID.String()))
+	}
+	if subrace == nil {
+		return failure.NewError(failure.ErrorBadRequest, errors.New("subrace cannot be nil"))
+	}
+
+	if err := validateSubrace(subrace); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid subrace data: %w", err))
+	}
+	if err := resolveProficiencies(s.repo.GetProficiency, subrace.Proficiencies); err != nil {
+		return err
+	}
+
+	siblings, err := s.repo.GetSubracesByRace(raceID)
+	if err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update subrace info: %w", err))
+	}
+	for _, sibling := range siblings {
+		if sibling.ID != subraceID && sibling.Name == subrace.Name {
+			return failure.NewError(failure.ErrorConflict, fmt.Errorf("subrace with name '%s' already exists for race ID %s", subrace.Name, raceID.String()))
+		}
+	}
+
+	if err := s.repo.UpdateSubrace(raceID, subraceID, subrace); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update subrace info: %w", err))
+	}
+	return nil
+}
+
+func (s *subraceServiceImpl) PatchSubraceInfo(raceID uuid.UUID, subraceID uuid.UUID, patch *models.SubracePatch) (*models.Subrace, error) {
+	if patch == nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("subrace patch cannot be nil"))
+	}
+
+	subrace, err := s.GetSubraceDetails(raceID, subraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	patch.Apply(subrace)
+	if err := s.UpdateSubraceInfo(raceID, subraceID, subrace); err != nil {
+		return nil, err
+	}
+	return subrace, nil
+}
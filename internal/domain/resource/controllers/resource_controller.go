@@ -0,0 +1,18 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type ResourceController interface {
+	GetAllTrackers(ctx *gin.Context)
+	GetTrackerByID(ctx *gin.Context)
+	CreateTracker(ctx *gin.Context)
+	UpdateTracker(ctx *gin.Context)
+	DeleteTracker(ctx *gin.Context)
+	UseResource(ctx *gin.Context)
+	ShortRest(ctx *gin.Context)
+	LongRest(ctx *gin.Context)
+	Dawn(ctx *gin.Context)
+	RollRecharges(ctx *gin.Context)
+}
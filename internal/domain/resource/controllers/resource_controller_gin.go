@@ -0,0 +1,345 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// resourceControllerGin is a concrete implementation of ResourceController using the Gin framework.
+type resourceControllerGin struct {
+	service services.ResourceService
+}
+
+// NewResourceControllerGin creates a new instance of resourceControllerGin.
+func NewResourceControllerGin(service services.ResourceService) ResourceController {
+	return &resourceControllerGin{
+		service: service,
+	}
+}
+
+// GetAllTrackers godoc
+// @Summary      List resource trackers
+// @Description  Retrieve resource trackers, optionally only the one of an owner
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        owner_id  query     string  false  "Owner ID (UUID)"
+// @Success      200       {array}   models.ResourceTracker
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      500       {object}  httperror.ErrorResponse
+// @Router       /trackers [get]
+func (c *resourceControllerGin) GetAllTrackers(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	trackers, err := c.service.ListTrackers(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, trackers)
+}
+
+// GetTrackerByID godoc
+// @Summary      Get resource tracker by ID
+// @Description  Retrieve a resource tracker using the provided ID
+// @Tags         Resource Trackers
+// @Produce      json
+// @Param        id   path      string  true  "Resource tracker ID (UUID)"
+// @Success      200  {object}  models.ResourceTracker
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /trackers/{id} [get]
+func (c *resourceControllerGin) GetTrackerByID(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.GetTracker(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// CreateTracker godoc
+// @Summary      Create resource tracker
+// @Description  Start tracking the limited-use resources of an owner. The limited-use traits of the given race and subrace and the given hit dice are added automatically. Each owner has at most one tracker.
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        tracker  body      models.ResourceTrackerRequest  true  "Resources"
+// @Success      201      {object}  models.ResourceTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Router       /trackers [post]
+func (c *resourceControllerGin) CreateTracker(ctx *gin.Context) {
+	var request models.ResourceTrackerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.CreateTracker(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, tracker)
+}
+
+// UpdateTracker godoc
+// @Summary      Update resource tracker
+// @Description  Replace the resources of a tracker, for example after a level up. Spent uses of a kept resource stay spent up to its new maximum.
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                         true  "Resource tracker ID (UUID)"
+// @Param        tracker  body      models.ResourceTrackerRequest  true  "Resources"
+// @Success      200      {object}  models.ResourceTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Router       /trackers/{id} [put]
+func (c *resourceControllerGin) UpdateTracker(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.ResourceTrackerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.UpdateTrackerResources(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteTracker godoc
+// @Summary      Delete resource tracker
+// @Description  Stop tracking limited-use resources
+// @Tags         Resource Trackers
+// @Param        id   path      string  true  "Resource tracker ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /trackers/{id} [delete]
+func (c *resourceControllerGin) DeleteTracker(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveTracker(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// UseResource godoc
+// @Summary      Use resource
+// @Description  Spend uses of a resource. Fails with 409 when not enough uses are left.
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                     true  "Resource tracker ID (UUID)"
+// @Param        request  body      models.UseResourceRequest  true  "Resource and amount"
+// @Success      200      {object}  models.ResourceTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Router       /trackers/{id}/use [post]
+func (c *resourceControllerGin) UseResource(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.UseResourceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.UseResource(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ShortRest godoc
+// @Summary      Short rest
+// @Description  Recover every short rest resource, optionally spending hit dice to regain hit points first
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                   true  "Resource tracker ID (UUID)"
+// @Param        request  body      models.ShortRestRequest  false  "Hit dice to spend"
+// @Success      200      {object}  models.RestResult
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Router       /trackers/{id}/rest/short [post]
+func (c *resourceControllerGin) ShortRest(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.ShortRestRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.ShortRest(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// LongRest godoc
+// @Summary      Long rest
+// @Description  Recover every short and long rest resource and half of the hit dice
+// @Tags         Resource Trackers
+// @Produce      json
+// @Param        id   path      string  true  "Resource tracker ID (UUID)"
+// @Success      200  {object}  models.RestResult
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /trackers/{id}/rest/long [post]
+func (c *resourceControllerGin) LongRest(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.LongRest(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// Dawn godoc
+// @Summary      Dawn
+// @Description  Recover every resource that recharges at dawn
+// @Tags         Resource Trackers
+// @Produce      json
+// @Param        id   path      string  true  "Resource tracker ID (UUID)"
+// @Success      200  {object}  models.RestResult
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /trackers/{id}/dawn [post]
+func (c *resourceControllerGin) Dawn(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.Dawn(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// RollRecharges godoc
+// @Summary      Roll recharges
+// @Description  Roll a d6 for every spent resource with a recharge roll, such as Recharge 5-6, recovering those that succeed
+// @Tags         Resource Trackers
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                  true  "Resource tracker ID (UUID)"
+// @Param        request  body      models.RechargeRequest  false  "Optional seed"
+// @Success      200      {object}  models.RestResult
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Router       /trackers/{id}/recharge [post]
+func (c *resourceControllerGin) RollRecharges(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.RechargeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	result, err := c.service.RollRecharges(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// parseTrackerID reads the resource tracker ID path parameter.
+func parseTrackerID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource tracker ID: %w", err))
+	}
+	return id, nil
+}
@@ -0,0 +1,257 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/google/uuid"
+)
+
+// Where a resource comes from. Trait resources are seeded from the limited-use traits of a race.
+const (
+	SourceCustom  = "custom"
+	SourceTrait   = "trait"
+	SourceHitDice = "hit_dice"
+)
+
+// ErrResourceExhausted is returned when a resource does not have enough uses left.
+var ErrResourceExhausted = errors.New("not enough uses left")
+
+// ResourceTracker tracks the limited-use resources of an owner, usually a character.
+type ResourceTracker struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	OwnerID   uuid.UUID  `json:"owner_id" gorm:"type:uuid;not null;uniqueIndex" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Resources []Resource `json:"resources" gorm:"type:jsonb;serializer:json"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Resource is one limited-use resource, such as Ki points, Rage or a breath weapon. Hit dice resources record their
+// die size and recover only half of their maximum on a long rest.
+type Resource struct {
+	Name       string     `json:"name" example:"Ki"`
+	Source     string     `json:"source" enums:"custom,trait,hit_dice" example:"custom"`
+	TraitID    *uuid.UUID `json:"trait_id,omitempty" swaggertype:"string" format:"uuid"`
+	Max        int        `json:"max" example:"5"`
+	Used       int        `json:"used" example:"2"`
+	Recharge   string     `json:"recharge" enums:"short_rest,long_rest,dawn,recharge_roll" example:"short_rest"`
+	RechargeOn int        `json:"recharge_on,omitempty" example:"5"`
+	Die        int        `json:"die,omitempty" example:"10"`
+}
+
+// HitDicePool is a number of hit dice of one size.
+type HitDicePool struct {
+	Die   int `json:"die" binding:"required" example:"10"`
+	Count int `json:"count" binding:"required" example:"5"`
+}
+
+// ResourceTrackerRequest sets the resources of a tracker. Besides the custom Resources, the limited-use traits of
+// RaceID and SubraceID are added automatically, as is one hit dice resource per pool in HitDice.
+type ResourceTrackerRequest struct {
+	OwnerID   uuid.UUID     `json:"owner_id" binding:"required" swaggertype:"string" format:"uuid"`
+	RaceID    *uuid.UUID    `json:"race_id,omitempty" swaggertype:"string" format:"uuid"`
+	SubraceID *uuid.UUID    `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	HitDice   []HitDicePool `json:"hit_dice,omitempty"`
+	Resources []Resource    `json:"resources,omitempty"`
+}
+
+// UseResourceRequest spends uses of a resource.
+type UseResourceRequest struct {
+	Name   string `json:"name" binding:"required" example:"Ki"`
+	Amount int    `json:"amount,omitempty" example:"1"`
+}
+
+// ShortRestRequest takes a short rest, optionally spending hit dice of the given size to regain hit points.
+type ShortRestRequest struct {
+	HitDice     int     `json:"hit_dice,omitempty" example:"2"`
+	Die         int     `json:"die,omitempty" example:"10"`
+	ConModifier int     `json:"con_modifier,omitempty" example:"2"`
+	Seed        *uint64 `json:"seed,omitempty"`
+}
+
+// RechargeRequest rolls for every spent recharge_roll resource, optionally with a seed to reproduce the rolls.
+type RechargeRequest struct {
+	Seed *uint64 `json:"seed,omitempty"`
+}
+
+// Recovery is the number of uses a resource regained.
+type Recovery struct {
+	Name      string `json:"name" example:"Ki"`
+	Recovered int    `json:"recovered" example:"2"`
+}
+
+// RechargeRoll is the d6 rolled for a recharge_roll resource.
+type RechargeRoll struct {
+	Name      string `json:"name" example:"Breath Weapon"`
+	Roll      int    `json:"roll" example:"5"`
+	Recharged bool   `json:"recharged"`
+}
+
+// RestResult reports what a rest or recharge recovered and the tracker afterwards.
+type RestResult struct {
+	Recovered         []Recovery       `json:"recovered"`
+	HitDiceRolls      []int            `json:"hit_dice_rolls,omitempty"`
+	HitPointsRegained int              `json:"hit_points_regained,omitempty"`
+	RechargeRolls     []RechargeRoll   `json:"recharge_rolls,omitempty"`
+	Seed              *uint64          `json:"seed,omitempty"`
+	Tracker           *ResourceTracker `json:"tracker"`
+}
+
+// Available returns the uses of the resource that are left.
+func (r Resource) Available() int {
+	return r.Max - r.Used
+}
+
+// HitDiceResourceName names the hit dice resource of the given die size.
+func HitDiceResourceName(die int) string {
+	return fmt.Sprintf("Hit Dice (d%d)", die)
+}
+
+// TraitResource returns the resource tracking a limited-use trait.
+func TraitResource(trait raceModels.Trait) Resource {
+	return Resource{
+		Name:       trait.Name,
+		Source:     SourceTrait,
+		TraitID:    &trait.ID,
+		Max:        trait.LimitedUse.Uses,
+		Recharge:   trait.LimitedUse.Recharge,
+		RechargeOn: trait.LimitedUse.RechargeOn,
+	}
+}
+
+// Resource returns the resource with the given name, ignoring case, or nil when the tracker has none.
+func (t *ResourceTracker) Resource(name string) *Resource {
+	for i := range t.Resources {
+		if strings.EqualFold(t.Resources[i].Name, name) {
+			return &t.Resources[i]
+		}
+	}
+	return nil
+}
+
+// SetResources replaces the tracked resources. Uses already spent of a resource that is kept stay spent, up to its
+// new maximum.
+func (t *ResourceTracker) SetResources(resources []Resource) {
+	used := make(map[string]int, len(t.Resources))
+	for _, resource := range t.Resources {
+		used[strings.ToLower(resource.Name)] = resource.Used
+	}
+
+	t.Resources = resources
+	for i := range t.Resources {
+		t.Resources[i].Used = min(used[strings.ToLower(t.Resources[i].Name)], t.Resources[i].Max)
+	}
+}
+
+// Use spends amount uses of the named resource.
+func (t *ResourceTracker) Use(name string, amount int) error {
+	resource := t.Resource(name)
+	if resource == nil {
+		return fmt.Errorf("tracker has no resource named '%s'", name)
+	}
+	if resource.Available() < amount {
+		return fmt.Errorf("%s has %d of %d uses left: %w", resource.Name, resource.Available(), amount, ErrResourceExhausted)
+	}
+	resource.Used += amount
+	return nil
+}
+
+// ShortRest recovers every short rest resource.
+func (t *ResourceTracker) ShortRest() []Recovery {
+	return t.recover(func(resource *Resource) int {
+		if resource.Recharge == raceModels.RechargeShortRest {
+			return resource.Used
+		}
+		return 0
+	})
+}
+
+// LongRest recovers every short and long rest resource, except hit dice which recover half their maximum, at least
+// one.
+func (t *ResourceTracker) LongRest() []Recovery {
+	return t.recover(func(resource *Resource) int {
+		switch {
+		case resource.Source == SourceHitDice:
+			return min(resource.Used, max(1, resource.Max/2))
+		case resource.Recharge == raceModels.RechargeShortRest, resource.Recharge == raceModels.RechargeLongRest:
+			return resource.Used
+		}
+		return 0
+	})
+}
+
+// Dawn recovers every resource that recharges at dawn.
+func (t *ResourceTracker) Dawn() []Recovery {
+	return t.recover(func(resource *Resource) int {
+		if resource.Recharge == raceModels.RechargeDawn {
+			return resource.Used
+		}
+		return 0
+	})
+}
+
+// RollRecharges rolls a d6 for every spent recharge_roll resource, recovering the resource when the roll reaches its
+// RechargeOn.
+func (t *ResourceTracker) RollRecharges(roller *dice.Roller) ([]RechargeRoll, []Recovery) {
+	d6 := dice.MustParse("1d6")
+	rolls := []RechargeRoll{}
+	recovered := t.recover(func(resource *Resource) int {
+		if resource.Recharge != raceModels.RechargeRoll || resource.Used == 0 {
+			return 0
+		}
+		roll := RechargeRoll{Name: resource.Name, Roll: roller.Roll(d6).Total}
+		roll.Recharged = roll.Roll >= resource.RechargeOn
+		rolls = append(rolls, roll)
+		if roll.Recharged {
+			return resource.Used
+		}
+		return 0
+	})
+	return rolls, recovered
+}
+
+// SpendHitDice spends count hit dice of the given size, rolling each and adding conModifier, and returns the rolls and
+// the hit points regained. Each die regains at least 0 hit points.
+func (t *ResourceTracker) SpendHitDice(die int, count int, conModifier int, roller *dice.Roller) ([]int, int, error) {
+	if err := t.Use(HitDiceResourceName(die), count); err != nil {
+		return nil, 0, err
+	}
+
+	hitDie := dice.MustParse(fmt.Sprintf("1d%d", die))
+	rolls := make([]int, 0, count)
+	regained := 0
+	for range count {
+		roll := roller.Roll(hitDie).Total
+		rolls = append(rolls, roll)
+		regained += max(0, roll+conModifier)
+	}
+	return rolls, regained, nil
+}
+
+// HitDiceSizes returns the die sizes of the tracked hit dice.
+func (t *ResourceTracker) HitDiceSizes() []int {
+	sizes := []int{}
+	for _, resource := range t.Resources {
+		if resource.Source == SourceHitDice {
+			sizes = append(sizes, resource.Die)
+		}
+	}
+	return sizes
+}
+
+// recover gives back the uses returned by amount for each resource and reports the resources that regained any.
+func (t *ResourceTracker) recover(amount func(resource *Resource) int) []Recovery {
+	recovered := []Recovery{}
+	for i := range t.Resources {
+		resource := &t.Resources[i]
+		if n := amount(resource); n > 0 {
+			resource.Used -= n
+			recovered = append(recovered, Recovery{Name: resource.Name, Recovered: n})
+		}
+	}
+	return recovered
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	"github.com/google/uuid"
+)
+
+type ResourceRepository interface {
+	GetAllTrackers(criteria map[string]string) ([]*models.ResourceTracker, error)
+	GetTrackerByID(id uuid.UUID) (*models.ResourceTracker, error)
+	GetTrackerByOwnerID(ownerID uuid.UUID) (*models.ResourceTracker, error)
+	CreateTracker(tracker *models.ResourceTracker) error
+	UpdateTracker(id uuid.UUID, update func(tracker *models.ResourceTracker) error) (*models.ResourceTracker, error)
+	DeleteTracker(id uuid.UUID) error
+}
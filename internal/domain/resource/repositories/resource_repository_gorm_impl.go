@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// resourceRepositoryGormImpl is a concrete implementation of the ResourceRepository interface using GORM.
+type resourceRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormResourceRepository creates a new instance of resourceRepositoryGormImpl.
+func NewGormResourceRepository(db *gorm.DB) ResourceRepository {
+	return &resourceRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllTrackers retrieves the resource trackers matching the given criteria. Criteria values are expected to be
+// validated by the caller.
+func (r *resourceRepositoryGormImpl) GetAllTrackers(criteria map[string]string) ([]*models.ResourceTracker, error) {
+	var trackers []*models.ResourceTracker
+	query := r.db.Order("created_at")
+
+	for key, value := range criteria {
+		switch key {
+		case "owner_id":
+			query = query.Where("owner_id = ?", value)
+		default:
+			return nil, fmt.Errorf("unknown resource tracker filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&trackers).Error; err != nil {
+		return nil, err
+	}
+	return trackers, nil
+}
+
+// GetTrackerByID retrieves a resource tracker by its ID.
+func (r *resourceRepositoryGormImpl) GetTrackerByID(id uuid.UUID) (*models.ResourceTracker, error) {
+	var tracker models.ResourceTracker
+	if err := r.db.First(&tracker, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("resource tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// GetTrackerByOwnerID retrieves the resource tracker of an owner.
+func (r *resourceRepositoryGormImpl) GetTrackerByOwnerID(ownerID uuid.UUID) (*models.ResourceTracker, error) {
+	var tracker models.ResourceTracker
+	if err := r.db.First(&tracker, "owner_id = ?", ownerID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("resource tracker of owner %s %w", ownerID.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// CreateTracker adds a new resource tracker to the database.
+func (r *resourceRepositoryGormImpl) CreateTracker(tracker *models.ResourceTracker) error {
+	return r.db.Create(tracker).Error
+}
+
+// UpdateTracker locks a resource tracker, applies update to it and saves the result in a single transaction, so
+// concurrent updates of the same tracker are applied one after another and a use is never spent twice. Nothing is
+// saved when update fails.
+func (r *resourceRepositoryGormImpl) UpdateTracker(id uuid.UUID, update func(tracker *models.ResourceTracker) error) (*models.ResourceTracker, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var tracker models.ResourceTracker
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&tracker, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("resource tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+
+	if err := update(&tracker); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Save(&tracker).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// DeleteTracker removes a resource tracker from the database.
+func (r *resourceRepositoryGormImpl) DeleteTracker(id uuid.UUID) error {
+	result := r.db.Delete(&models.ResourceTracker{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("resource tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
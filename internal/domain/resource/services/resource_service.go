@@ -0,0 +1,19 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	"github.com/google/uuid"
+)
+
+type ResourceService interface {
+	ListTrackers(criteria map[string]string) ([]*models.ResourceTracker, error)
+	GetTracker(id uuid.UUID) (*models.ResourceTracker, error)
+	CreateTracker(request *models.ResourceTrackerRequest) (*models.ResourceTracker, error)
+	UpdateTrackerResources(id uuid.UUID, request *models.ResourceTrackerRequest) (*models.ResourceTracker, error)
+	RemoveTracker(id uuid.UUID) error
+	UseResource(id uuid.UUID, request *models.UseResourceRequest) (*models.ResourceTracker, error)
+	ShortRest(id uuid.UUID, request *models.ShortRestRequest) (*models.RestResult, error)
+	LongRest(id uuid.UUID) (*models.RestResult, error)
+	Dawn(id uuid.UUID) (*models.RestResult, error)
+	RollRecharges(id uuid.UUID, request *models.RechargeRequest) (*models.RestResult, error)
+}
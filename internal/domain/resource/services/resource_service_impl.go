@@ -0,0 +1,334 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/resource/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const maxHitDice = 20
+
+var validHitDice = []int{6, 8, 10, 12}
+
+// resourceServiceImpl is the concrete implementation of ResourceService.
+type resourceServiceImpl struct {
+	repo        repositories.ResourceRepository
+	raceService raceServices.RaceService
+}
+
+// NewResourceService creates a new instance of resourceServiceImpl.
+func NewResourceService(repo repositories.ResourceRepository, raceService raceServices.RaceService) ResourceService {
+	return &resourceServiceImpl{
+		repo:        repo,
+		raceService: raceService,
+	}
+}
+
+func (s *resourceServiceImpl) ListTrackers(criteria map[string]string) ([]*models.ResourceTracker, error) {
+	if err := validateCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource tracker filter: %w", err))
+	}
+
+	trackers, err := s.repo.GetAllTrackers(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list resource trackers: %w", err))
+	}
+	return trackers, nil
+}
+
+func (s *resourceServiceImpl) GetTracker(id uuid.UUID) (*models.ResourceTracker, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource tracker ID: %s", id.String()))
+	}
+
+	tracker, err := s.repo.GetTrackerByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get resource tracker by ID: %w", err))
+	}
+	return tracker, nil
+}
+
+func (s *resourceServiceImpl) CreateTracker(request *models.ResourceTrackerRequest) (*models.ResourceTracker, error) {
+	if request.OwnerID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("owner ID cannot be empty"))
+	}
+
+	resources, err := s.buildResources(request)
+	if err != nil {
+		return nil, err
+	}
+
+	existingTracker, _ := s.repo.GetTrackerByOwnerID(request.OwnerID)
+	if existingTracker != nil {
+		return nil, failure.NewError(failure.ErrorConflict, fmt.Errorf("owner %s already has resource tracker %s", request.OwnerID.String(), existingTracker.ID.String()))
+	}
+
+	tracker := &models.ResourceTracker{OwnerID: request.OwnerID}
+	tracker.SetResources(resources)
+	if err := s.repo.CreateTracker(tracker); err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to create resource tracker: %w", err))
+	}
+	return tracker, nil
+}
+
+func (s *resourceServiceImpl) UpdateTrackerResources(id uuid.UUID, request *models.ResourceTrackerRequest) (*models.ResourceTracker, error) {
+	resources, err := s.buildResources(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(id, "update resources", func(tracker *models.ResourceTracker) error {
+		if request.OwnerID != tracker.OwnerID {
+			return failure.NewError(failure.ErrorBadRequest, errors.New("the owner of a resource tracker cannot change"))
+		}
+		tracker.SetResources(resources)
+		return nil
+	})
+}
+
+func (s *resourceServiceImpl) RemoveTracker(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource tracker ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteTracker(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove resource tracker: %w", err))
+	}
+	return nil
+}
+
+func (s *resourceServiceImpl) UseResource(id uuid.UUID, request *models.UseResourceRequest) (*models.ResourceTracker, error) {
+	if request.Amount == 0 {
+		request.Amount = 1
+	}
+	if request.Amount < 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("amount must be positive: %d", request.Amount))
+	}
+
+	return s.update(id, "use resource", func(tracker *models.ResourceTracker) error {
+		return useError(tracker.Use(request.Name, request.Amount))
+	})
+}
+
+func (s *resourceServiceImpl) ShortRest(id uuid.UUID, request *models.ShortRestRequest) (*models.RestResult, error) {
+	if request.HitDice < 0 {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("hit dice to spend cannot be negative: %d", request.HitDice))
+	}
+
+	result := &models.RestResult{}
+	tracker, err := s.update(id, "take a short rest", func(tracker *models.ResourceTracker) error {
+		if request.HitDice > 0 {
+			die := request.Die
+			if sizes := tracker.HitDiceSizes(); die == 0 && len(sizes) == 1 {
+				die = sizes[0]
+			}
+			if die == 0 {
+				return failure.NewError(failure.ErrorBadRequest, errors.New("choose the die size of the hit dice to spend"))
+			}
+
+			seed := rand.Uint64()
+			if request.Seed != nil {
+				seed = *request.Seed
+			}
+			rolls, regained, err := tracker.SpendHitDice(die, request.HitDice, request.ConModifier, dice.NewSeededRoller(seed))
+			if err != nil {
+				return useError(err)
+			}
+			result.HitDiceRolls, result.HitPointsRegained, result.Seed = rolls, regained, &seed
+		}
+		result.Recovered = tracker.ShortRest()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Tracker = tracker
+	return result, nil
+}
+
+func (s *resourceServiceImpl) LongRest(id uuid.UUID) (*models.RestResult, error) {
+	result := &models.RestResult{}
+	tracker, err := s.update(id, "take a long rest", func(tracker *models.ResourceTracker) error {
+		result.Recovered = tracker.LongRest()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Tracker = tracker
+	return result, nil
+}
+
+func (s *resourceServiceImpl) Dawn(id uuid.UUID) (*models.RestResult, error) {
+	result := &models.RestResult{}
+	tracker, err := s.update(id, "recover resources at dawn", func(tracker *models.ResourceTracker) error {
+		result.Recovered = tracker.Dawn()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Tracker = tracker
+	return result, nil
+}
+
+func (s *resourceServiceImpl) RollRecharges(id uuid.UUID, request *models.RechargeRequest) (*models.RestResult, error) {
+	seed := rand.Uint64()
+	if request.Seed != nil {
+		seed = *request.Seed
+	}
+
+	result := &models.RestResult{Seed: &seed}
+	tracker, err := s.update(id, "roll recharges", func(tracker *models.ResourceTracker) error {
+		result.RechargeRolls, result.Recovered = tracker.RollRecharges(dice.NewSeededRoller(seed))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Tracker = tracker
+	return result, nil
+}
+
+// update applies change to the tracker while it is locked against concurrent updates.
+func (s *resourceServiceImpl) update(id uuid.UUID, action string, change func(tracker *models.ResourceTracker) error) (*models.ResourceTracker, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource tracker ID: %s", id.String()))
+	}
+
+	tracker, err := s.repo.UpdateTracker(id, change)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to %s: %w", action, err))
+	}
+	return tracker, nil
+}
+
+// buildResources validates the requested resources and adds the hit dice and the limited-use traits of the race and
+// subrace to them.
+func (s *resourceServiceImpl) buildResources(request *models.ResourceTrackerRequest) ([]models.Resource, error) {
+	resources := []models.Resource{}
+	for _, resource := range request.Resources {
+		if err := validateResource(&resource); err != nil {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid resource data: %w", err))
+		}
+		resources = append(resources, resource)
+	}
+
+	for _, pool := range request.HitDice {
+		if !slices.Contains(validHitDice, pool.Die) {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid hit die: d%d", pool.Die))
+		}
+		if pool.Count < 1 || pool.Count > maxHitDice {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("hit dice count must be between 1 and %d: %d", maxHitDice, pool.Count))
+		}
+		resources = append(resources, models.Resource{
+			Name:     models.HitDiceResourceName(pool.Die),
+			Source:   models.SourceHitDice,
+			Max:      pool.Count,
+			Recharge: raceModels.RechargeLongRest,
+			Die:      pool.Die,
+		})
+	}
+
+	traits, err := s.racialTraits(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, trait := range traits {
+		if trait.LimitedUse != nil {
+			resources = append(resources, models.TraitResource(trait))
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, resource := range resources {
+		key := strings.ToLower(resource.Name)
+		if seen[key] {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("resource '%s' is listed more than once", resource.Name))
+		}
+		seen[key] = true
+	}
+	return resources, nil
+}
+
+// racialTraits returns the traits of the requested race, merged with its subrace when one is given.
+func (s *resourceServiceImpl) racialTraits(request *models.ResourceTrackerRequest) ([]raceModels.Trait, error) {
+	if request.RaceID == nil {
+		if request.SubraceID != nil {
+			return nil, failure.NewError(failure.ErrorBadRequest, errors.New("a subrace requires its race"))
+		}
+		return nil, nil
+	}
+
+	if request.SubraceID == nil {
+		race, err := s.raceService.GetRaceDetails(*request.RaceID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		return race.Traits, nil
+	}
+
+	effective, err := s.raceService.ResolveEffectiveRace(*request.RaceID, *request.SubraceID)
+	if err != nil {
+		return nil, failure.NotFoundAsBadRequest(err)
+	}
+	traits := make([]raceModels.Trait, 0, len(effective.Traits))
+	for _, trait := range effective.Traits {
+		traits = append(traits, trait.Trait)
+	}
+	return traits, nil
+}
+
+// useError reports spending more uses than are left as a conflict and any other failure as a bad request.
+func useError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, models.ErrResourceExhausted) {
+		return failure.NewError(failure.ErrorConflict, err)
+	}
+	return failure.NewError(failure.ErrorBadRequest, err)
+}
+
+func validateResource(resource *models.Resource) error {
+	resource.Name = strings.TrimSpace(resource.Name)
+	if resource.Name == "" {
+		return errors.New("resource name cannot be empty")
+	}
+
+	usage := raceModels.LimitedUse{Uses: resource.Max, Recharge: resource.Recharge, RechargeOn: resource.RechargeOn}
+	if err := usage.Validate(); err != nil {
+		return fmt.Errorf("resource '%s': %w", resource.Name, err)
+	}
+
+	resource.Source = models.SourceCustom
+	resource.TraitID = nil
+	resource.RechargeOn = usage.RechargeOn
+	resource.Used = 0
+	resource.Die = 0
+	return nil
+}
+
+func validateCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "owner_id":
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("owner_id must be a UUID: %s", value)
+			}
+		default:
+			return fmt.Errorf("unknown filter '%s', the allowed filter is owner_id", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type RulesController interface {
+	DerivedStats(ctx *gin.Context)
+	Multiclass(ctx *gin.Context)
+}
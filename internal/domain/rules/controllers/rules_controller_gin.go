@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/rules/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/rules/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// rulesControllerGin is a concrete implementation of RulesController using the Gin framework.
+type rulesControllerGin struct {
+	service services.RulesService
+}
+
+// NewRulesControllerGin creates a new instance of rulesControllerGin.
+func NewRulesControllerGin(service services.RulesService) RulesController {
+	return &rulesControllerGin{
+		service: service,
+	}
+}
+
+// DerivedStats godoc
+// @Summary      Calculate derived stats
+// @Description  Calculate proficiency bonus, ability modifiers, hit points, armor class, initiative, speed, skill modifiers and passive perception from final ability scores, race, level and worn armor
+// @Tags         Rules
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.DerivedStatsRequest  true  "Character build"
+// @Success      200      {object}  models.DerivedStats
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Router       /rules/derived-stats [post]
+func (c *rulesControllerGin) DerivedStats(ctx *gin.Context) {
+	var request models.DerivedStatsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	stats, err := c.service.CalculateDerivedStats(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, stats)
+}
+
+// Multiclass godoc
+// @Summary      Check multiclassing
+// @Description  Apply the multiclassing rules to per-class levels: check the ability prerequisites of every class against the scores with racial bonuses applied, compute the combined caster level, spell slots and pact magic, and list the proficiencies gained from each class after the first
+// @Tags         Rules
+// @Accept       json
+// @Produce      json
+// @Param        request  body      models.MulticlassRequest  true  "Classes, race and base ability scores"
+// @Success      200      {object}  models.Multiclass
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Router       /rules/multiclass [post]
+func (c *rulesControllerGin) Multiclass(ctx *gin.Context) {
+	var request models.MulticlassRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	multiclass, err := c.service.CheckMulticlass(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, multiclass)
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	equipmentModels "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	"github.com/google/uuid"
+)
+
+const (
+	UnarmoredDefenseNone      = ""
+	UnarmoredDefenseBarbarian = "barbarian"
+	UnarmoredDefenseMonk      = "monk"
+)
+
+const (
+	ACSourceUnarmored        = "unarmored"
+	ACSourceUnarmoredDefense = "unarmored_defense"
+	ACSourceArmor            = "armor"
+)
+
+// heavyArmorSpeedPenalty is the speed lost when wearing heavy armor without its strength requirement.
+const heavyArmorSpeedPenalty = 10
+
+// DerivedStatsRequest describes a character to compute derived statistics for. AbilityScores are final scores,
+// racial bonuses included.
+type DerivedStatsRequest struct {
+	RaceID              uuid.UUID            `json:"race_id" binding:"required" swaggertype:"string" format:"uuid"`
+	SubraceID           *uuid.UUID           `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	Level               int                  `json:"level" binding:"required" example:"5"`
+	HitDie              int                  `json:"hit_die" binding:"required" example:"10"`
+	AbilityScores       models.AbilityScores `json:"ability_scores" binding:"required"`
+	SkillProficiencyIDs []uuid.UUID          `json:"skill_proficiency_ids,omitempty" swaggertype:"array,string"`
+	ArmorID             *uuid.UUID           `json:"armor_id,omitempty" swaggertype:"string" format:"uuid"`
+	ShieldID            *uuid.UUID           `json:"shield_id,omitempty" swaggertype:"string" format:"uuid"`
+	UnarmoredDefense    string               `json:"unarmored_defense,omitempty" enums:"barbarian,monk"`
+}
+
+// SkillModifier is the check modifier of one skill.
+type SkillModifier struct {
+	ProficiencyID uuid.UUID `json:"proficiency_id" swaggertype:"string" format:"uuid"`
+	Name          string    `json:"name" example:"Perception"`
+	Ability       string    `json:"ability" example:"wisdom"`
+	Proficient    bool      `json:"proficient"`
+	Modifier      int       `json:"modifier" example:"3"`
+}
+
+// ArmorClass is a computed armor class and what it is based on.
+type ArmorClass struct {
+	Value  int    `json:"value" example:"16"`
+	Source string `json:"source" enums:"unarmored,unarmored_defense,armor" example:"armor"`
+	Shield bool   `json:"shield"`
+}
+
+// DerivedStats are the statistics computed from a character's race, level, class and ability scores.
+type DerivedStats struct {
+	Level               int                  `json:"level" example:"5"`
+	ProficiencyBonus    int                  `json:"proficiency_bonus" example:"3"`
+	AbilityModifiers    models.AbilityScores `json:"ability_modifiers"`
+	MaxHitPoints        int                  `json:"max_hit_points" example:"44"`
+	ArmorClass          ArmorClass           `json:"armor_class"`
+	Initiative          int                  `json:"initiative" example:"2"`
+	Speed               int                  `json:"speed" example:"30"`
+	StealthDisadvantage bool                 `json:"stealth_disadvantage"`
+	Skills              []SkillModifier      `json:"skills"`
+	PassivePerception   int                  `json:"passive_perception" example:"13"`
+}
+
+// CalcProficiencyBonus returns the proficiency bonus of a character of the given level.
+func CalcProficiencyBonus(level int) int {
+	return classModels.ProficiencyBonus(level)
+}
+
+// CalcHP returns the maximum hit points of a character: the full hit die at level 1, then the fixed average of
+// hitDie/2+1 for every later level, each level adding the Constitution modifier and gaining at least 1 hit point.
+func CalcHP(hitDie int, level int, conModifier int) int {
+	hp := max(1, hitDie+conModifier)
+	for range level - 1 {
+		hp += max(1, hitDie/2+1+conModifier)
+	}
+	return hp
+}
+
+// CalcAC returns the armor class granted by the worn armor and shield, either of which may be nil. Without armor,
+// a barbarian or monk unarmored defense adds its second ability modifier; shields still apply.
+func CalcAC(scores models.AbilityScores, armor *equipmentModels.ArmorStats, shield *equipmentModels.ArmorStats, unarmoredDefense string) ArmorClass {
+	modifiers := scores.Modifiers()
+
+	ac := ArmorClass{Value: 10 + modifiers.Dexterity, Source: ACSourceUnarmored}
+	switch {
+	case armor != nil:
+		ac = ArmorClass{Value: armor.ArmorClass(modifiers.Dexterity), Source: ACSourceArmor}
+	case unarmoredDefense == UnarmoredDefenseBarbarian:
+		ac = ArmorClass{Value: 10 + modifiers.Dexterity + modifiers.Constitution, Source: ACSourceUnarmoredDefense}
+	case unarmoredDefense == UnarmoredDefenseMonk && shield == nil:
+		ac = ArmorClass{Value: 10 + modifiers.Dexterity + modifiers.Wisdom, Source: ACSourceUnarmoredDefense}
+	}
+
+	if shield != nil {
+		ac.Value += shield.ArmorClass(modifiers.Dexterity)
+		ac.Shield = true
+	}
+	return ac
+}
+
+// CalcSpeed returns the walking speed once armor is worn: heavy armor without its strength requirement costs 10 feet.
+func CalcSpeed(baseSpeed int, strength int, armor *equipmentModels.ArmorStats) int {
+	if armor != nil && armor.Type == equipmentModels.ArmorTypeHeavy && strength < armor.StrengthRequirement {
+		return max(0, baseSpeed-heavyArmorSpeedPenalty)
+	}
+	return baseSpeed
+}
+
+// CalcSkillModifier returns the check modifier of a skill based on the given ability score.
+func CalcSkillModifier(score int, proficient bool, proficiencyBonus int) int {
+	modifier := models.Modifier(score)
+	if proficient {
+		modifier += proficiencyBonus
+	}
+	return modifier
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+// ClassLevels is the number of levels a character has in one class.
+type ClassLevels struct {
+	ClassID uuid.UUID `json:"class_id" binding:"required" swaggertype:"string" format:"uuid"`
+	Level   int       `json:"level" binding:"required" example:"3"`
+}
+
+// MulticlassRequest describes a multiclassed character. Classes are listed in the order they were taken, the first
+// being the starting class, and AbilityScores are base scores the race and subrace bonuses are added to.
+type MulticlassRequest struct {
+	RaceID        uuid.UUID                     `json:"race_id" binding:"required" swaggertype:"string" format:"uuid"`
+	SubraceID     *uuid.UUID                    `json:"subrace_id,omitempty" swaggertype:"string" format:"uuid"`
+	Picks         []raceModels.AbilityBonusPick `json:"picks,omitempty" swaggertype:"array,object"`
+	AbilityScores models.AbilityScores          `json:"ability_scores" binding:"required"`
+	Classes       []ClassLevels                 `json:"classes" binding:"required,min=1"`
+}
+
+// PrerequisiteCheck is the outcome of one multiclass ability prerequisite.
+type PrerequisiteCheck struct {
+	ClassID     uuid.UUID `json:"class_id" swaggertype:"string" format:"uuid"`
+	ClassName   string    `json:"class_name" example:"Fighter"`
+	Requirement string    `json:"requirement" example:"strength 13 or dexterity 13"`
+	Met         bool      `json:"met"`
+}
+
+// MulticlassProficiencies are the proficiencies gained when entering a class other than the starting class.
+type MulticlassProficiencies struct {
+	ClassID       uuid.UUID                `json:"class_id" swaggertype:"string" format:"uuid"`
+	ClassName     string                   `json:"class_name" example:"Rogue"`
+	Proficiencies []raceModels.Proficiency `json:"proficiencies" swaggertype:"array,object"`
+	SkillChoices  int                      `json:"skill_choices" example:"1"`
+}
+
+// PactSlots are the pact magic slots of a pact caster, all of the same spell level.
+type PactSlots struct {
+	Slots     int `json:"slots" example:"2"`
+	SlotLevel int `json:"slot_level" example:"3"`
+}
+
+// Multiclass is the outcome of the multiclassing rules for a character.
+type Multiclass struct {
+	TotalLevel       int                       `json:"total_level" example:"8"`
+	ProficiencyBonus int                       `json:"proficiency_bonus" example:"3"`
+	AbilityScores    models.AbilityScores      `json:"ability_scores"`
+	Eligible         bool                      `json:"eligible"`
+	Prerequisites    []PrerequisiteCheck       `json:"prerequisites"`
+	CasterLevel      int                       `json:"caster_level" example:"5"`
+	SpellSlots       []int                     `json:"spell_slots" example:"4,3,2"`
+	PactMagic        *PactSlots                `json:"pact_magic,omitempty"`
+	Proficiencies    []MulticlassProficiencies `json:"proficiencies"`
+}
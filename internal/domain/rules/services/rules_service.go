@@ -0,0 +1,10 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/rules/models"
+)
+
+type RulesService interface {
+	CalculateDerivedStats(request *models.DerivedStatsRequest) (*models.DerivedStats, error)
+	CheckMulticlass(request *models.MulticlassRequest) (*models.Multiclass, error)
+}
@@ -0,0 +1,304 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	characterModels "github.com/Casagrande-Lucas/dnd/internal/domain/character/models"
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	classServices "github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	equipmentModels "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/models"
+	equipmentServices "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/services"
+	proficiencyServices "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
+	raceModels "github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	raceServices "github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/rules/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+const (
+	minAbilityScore = 1
+	maxAbilityScore = 30
+	perceptionSkill = "perception"
+	passiveBase     = 10
+)
+
+var validHitDice = []int{6, 8, 10, 12}
+
+// rulesServiceImpl is the concrete implementation of RulesService.
+type rulesServiceImpl struct {
+	raceService        raceServices.RaceService
+	proficiencyService proficiencyServices.ProficiencyService
+	equipmentService   equipmentServices.EquipmentService
+	classService       classServices.ClassService
+}
+
+// NewRulesService creates a new instance of rulesServiceImpl.
+func NewRulesService(
+	raceService raceServices.RaceService,
+	proficiencyService proficiencyServices.ProficiencyService,
+	equipmentService equipmentServices.EquipmentService,
+	classService classServices.ClassService,
+) RulesService {
+	return &rulesServiceImpl{
+		raceService:        raceService,
+		proficiencyService: proficiencyService,
+		equipmentService:   equipmentService,
+		classService:       classService,
+	}
+}
+
+func (s *rulesServiceImpl) CalculateDerivedStats(request *models.DerivedStatsRequest) (*models.DerivedStats, error) {
+	if err := validateRequest(request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid derived stats request: %w", err))
+	}
+
+	speed, racialProficiencies, err := s.raceFeatures(request)
+	if err != nil {
+		return nil, err
+	}
+
+	armor, err := s.wornArmor(request.ArmorID, false)
+	if err != nil {
+		return nil, err
+	}
+	shield, err := s.wornArmor(request.ShieldID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := request.AbilityScores
+	modifiers := scores.Modifiers()
+	stats := &models.DerivedStats{
+		Level:             request.Level,
+		ProficiencyBonus:  models.CalcProficiencyBonus(request.Level),
+		AbilityModifiers:  modifiers,
+		MaxHitPoints:      models.CalcHP(request.HitDie, request.Level, modifiers.Constitution),
+		ArmorClass:        models.CalcAC(scores, armor, shield, request.UnarmoredDefense),
+		Initiative:        modifiers.Dexterity,
+		Speed:             models.CalcSpeed(speed, scores.Strength, armor),
+		PassivePerception: passiveBase + modifiers.Wisdom,
+	}
+	if armor != nil {
+		stats.StealthDisadvantage = armor.StealthDisadvantage
+	}
+
+	stats.Skills, err = s.skillModifiers(request, racialProficiencies, stats.ProficiencyBonus)
+	if err != nil {
+		return nil, err
+	}
+	for _, skill := range stats.Skills {
+		if strings.EqualFold(skill.Name, perceptionSkill) {
+			stats.PassivePerception = passiveBase + skill.Modifier
+		}
+	}
+	return stats, nil
+}
+
+func (s *rulesServiceImpl) CheckMulticlass(request *models.MulticlassRequest) (*models.Multiclass, error) {
+	if err := validateMulticlassRequest(request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid multiclass request: %w", err))
+	}
+
+	resolved, err := s.raceService.ResolveAbilityBonuses(request.RaceID, &raceModels.AbilityBonusSelection{
+		SubraceID: request.SubraceID,
+		Picks:     request.Picks,
+	})
+	if err != nil {
+		return nil, failure.NotFoundAsBadRequest(err)
+	}
+
+	result := &models.Multiclass{
+		AbilityScores: request.AbilityScores,
+		Eligible:      true,
+		Prerequisites: []models.PrerequisiteCheck{},
+		Proficiencies: []models.MulticlassProficiencies{},
+	}
+	for _, bonus := range resolved.AbilityScoreBonuses {
+		result.AbilityScores.ModifyScore(bonus.Ability, bonus.Total)
+	}
+
+	classes := make([]*classModels.Class, 0, len(request.Classes))
+	for _, entry := range request.Classes {
+		class, err := s.classService.GetClassDetails(entry.ClassID)
+		if err != nil {
+			return nil, failure.NotFoundAsBadRequest(err)
+		}
+		classes = append(classes, class)
+		result.TotalLevel += entry.Level
+	}
+	result.ProficiencyBonus = classModels.ProficiencyBonus(result.TotalLevel)
+
+	multiclassed := len(classes) > 1
+	spellcasters := 0
+	for _, class := range classes {
+		if casterType := class.Spellcasting.CasterType; casterType != classModels.CasterTypeNone && casterType != classModels.CasterTypePact {
+			spellcasters++
+		}
+	}
+
+	pactLevel := 0
+	for i, class := range classes {
+		level := request.Classes[i].Level
+		result.CasterLevel += class.Spellcasting.CasterLevel(level, spellcasters > 1)
+		if class.Spellcasting.CasterType == classModels.CasterTypePact {
+			pactLevel += level
+		}
+
+		if !multiclassed {
+			continue
+		}
+		for _, prerequisite := range class.MulticlassPrerequisites {
+			check := models.PrerequisiteCheck{
+				ClassID:     class.ID,
+				ClassName:   class.Name,
+				Requirement: prerequisite.String(),
+				Met:         prerequisite.MetBy(result.AbilityScores.Get),
+			}
+			result.Eligible = result.Eligible && check.Met
+			result.Prerequisites = append(result.Prerequisites, check)
+		}
+		if i > 0 {
+			result.Proficiencies = append(result.Proficiencies, models.MulticlassProficiencies{
+				ClassID:       class.ID,
+				ClassName:     class.Name,
+				Proficiencies: class.MulticlassProficiencies,
+				SkillChoices:  class.MulticlassSkillChoices,
+			})
+		}
+	}
+
+	result.SpellSlots = classModels.SpellSlots(result.CasterLevel)
+	if pactLevel > 0 {
+		slots, slotLevel := classModels.PactMagicSlots(pactLevel)
+		result.PactMagic = &models.PactSlots{Slots: slots, SlotLevel: slotLevel}
+	}
+	return result, nil
+}
+
+// raceFeatures returns the walking speed and proficiencies of the requested race, merged with its subrace when one is given.
+func (s *rulesServiceImpl) raceFeatures(request *models.DerivedStatsRequest) (int, []raceModels.Proficiency, error) {
+	if request.SubraceID == nil {
+		race, err := s.raceService.GetRaceDetails(request.RaceID)
+		if err != nil {
+			return 0, nil, failure.NotFoundAsBadRequest(err)
+		}
+		return int(race.Speed), race.Proficiencies, nil
+	}
+
+	effective, err := s.raceService.ResolveEffectiveRace(request.RaceID, *request.SubraceID)
+	if err != nil {
+		return 0, nil, failure.NotFoundAsBadRequest(err)
+	}
+	proficiencies := make([]raceModels.Proficiency, 0, len(effective.Proficiencies))
+	for _, prof := range effective.Proficiencies {
+		proficiencies = append(proficiencies, prof.Proficiency)
+	}
+	return int(effective.Speed.Value), proficiencies, nil
+}
+
+// wornArmor loads the armor or shield with the given ID, which may be nil when nothing is worn.
+func (s *rulesServiceImpl) wornArmor(id *uuid.UUID, shield bool) (*equipmentModels.ArmorStats, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	item, err := s.equipmentService.GetEquipmentDetails(*id)
+	if err != nil {
+		return nil, failure.NotFoundAsBadRequest(err)
+	}
+	if item.Armor == nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s is not armor", item.Name))
+	}
+	if (item.Armor.Type == equipmentModels.ArmorTypeShield) != shield {
+		if shield {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s is not a shield", item.Name))
+		}
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("%s is a shield, not body armor", item.Name))
+	}
+	return item.Armor, nil
+}
+
+// skillModifiers computes every skill in the catalog, proficient when requested or granted by the race.
+func (s *rulesServiceImpl) skillModifiers(request *models.DerivedStatsRequest, racial []raceModels.Proficiency, proficiencyBonus int) ([]models.SkillModifier, error) {
+	skills, err := s.proficiencyService.ListProficiencies(map[string]string{"category": raceModels.ProficiencyCategorySkill})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range request.SkillProficiencyIDs {
+		if !slices.ContainsFunc(skills, func(skill *raceModels.Proficiency) bool { return skill.ID == id }) {
+			return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("proficiency %s is not a known skill", id.String()))
+		}
+	}
+
+	modifiers := make([]models.SkillModifier, 0, len(skills))
+	for _, skill := range skills {
+		proficient := slices.Contains(request.SkillProficiencyIDs, skill.ID) ||
+			slices.ContainsFunc(racial, func(prof raceModels.Proficiency) bool { return prof.ID == skill.ID })
+		modifiers = append(modifiers, models.SkillModifier{
+			ProficiencyID: skill.ID,
+			Name:          skill.Name,
+			Ability:       skill.Ability,
+			Proficient:    proficient,
+			Modifier:      models.CalcSkillModifier(request.AbilityScores.Get(skill.Ability), proficient, proficiencyBonus),
+		})
+	}
+	return modifiers, nil
+}
+
+func validateMulticlassRequest(request *models.MulticlassRequest) error {
+	if err := validateAbilityScores(request.AbilityScores); err != nil {
+		return err
+	}
+
+	total := 0
+	seen := make(map[uuid.UUID]bool)
+	for _, entry := range request.Classes {
+		if entry.ClassID == uuid.Nil {
+			return errors.New("class ID cannot be empty")
+		}
+		if seen[entry.ClassID] {
+			return fmt.Errorf("class %s is listed more than once", entry.ClassID.String())
+		}
+		seen[entry.ClassID] = true
+		if entry.Level < 1 {
+			return fmt.Errorf("class level must be at least 1: %d", entry.Level)
+		}
+		total += entry.Level
+	}
+	if total > classModels.MaxLevel {
+		return fmt.Errorf("total level cannot exceed %d: %d", classModels.MaxLevel, total)
+	}
+	return nil
+}
+
+func validateRequest(request *models.DerivedStatsRequest) error {
+	if request.Level < 1 || request.Level > classModels.MaxLevel {
+		return fmt.Errorf("level must be between 1 and %d: %d", classModels.MaxLevel, request.Level)
+	}
+	if !slices.Contains(validHitDice, request.HitDie) {
+		return fmt.Errorf("invalid hit die: d%d", request.HitDie)
+	}
+	if err := validateAbilityScores(request.AbilityScores); err != nil {
+		return err
+	}
+	switch request.UnarmoredDefense {
+	case models.UnarmoredDefenseNone, models.UnarmoredDefenseBarbarian, models.UnarmoredDefenseMonk:
+	default:
+		return fmt.Errorf("invalid unarmored defense: %s", request.UnarmoredDefense)
+	}
+	return nil
+}
+
+func validateAbilityScores(scores characterModels.AbilityScores) error {
+	for _, ability := range raceModels.Abilities {
+		if score := scores.Get(ability); score < minAbilityScore || score > maxAbilityScore {
+			return fmt.Errorf("%s score must be between %d and %d: %d", ability, minAbilityScore, maxAbilityScore, score)
+		}
+	}
+	return nil
+}
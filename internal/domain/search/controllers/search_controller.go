@@ -0,0 +1,10 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SearchController interface {
+	Search(ctx *gin.Context)
+	Suggest(ctx *gin.Context)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/search/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+// searchControllerGin is a concrete implementation of SearchController using the Gin framework.
+type searchControllerGin struct {
+	service services.SearchService
+}
+
+// NewSearchControllerGin creates a new instance of searchControllerGin.
+func NewSearchControllerGin(service services.SearchService) SearchController {
+	return &searchControllerGin{
+		service: service,
+	}
+}
+
+// Search godoc
+// @Summary      Search races, subraces and traits
+// @Description  Full-text search over the names and descriptions of races, subraces and traits, tolerant to typos in names. Hits are ranked best first and grouped by type, with the matched words of the name and description wrapped in <mark> tags. The name and snippet are HTML-escaped, so the tags are their only markup.
+// @Tags         Search
+// @Accept       json
+// @Produce      json
+// @Param        q      query     string  true  "Search text, such as dwarf hill"
+// @Param        types  query     string  false  "Comma-separated types to search among race, subrace and trait, all by default"
+// @Param        limit  query     int     false  "Hits per type, 10 by default and at most 50"
+// @Success      200    {object}  models.SearchResults
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      500    {object}  httperror.ErrorResponse
+// @Router       /search [get]
+func (c *searchControllerGin) Search(ctx *gin.Context) {
+	params := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+
+	results, err := c.service.Search(params)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, results)
+}
+
+// Suggest godoc
+// @Summary      Suggest names
+// @Description  Autocomplete the names of races, subraces and traits from the text typed so far. Names starting with the text come first, followed by names containing a similar word, so misspellings such as darkvsion still suggest Darkvision.
+// @Tags         Search
+// @Accept       json
+// @Produce      json
+// @Param        q      query     string  true  "Text typed so far"
+// @Param        limit  query     int     false  "Number of suggestions, 10 by default and at most 50"
+// @Success      200    {array}   models.Suggestion
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      500    {object}  httperror.ErrorResponse
+// @Router       /search/suggest [get]
+func (c *searchControllerGin) Suggest(ctx *gin.Context) {
+	params := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+
+	suggestions, err := c.service.Suggest(params)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, suggestions)
+}
@@ -0,0 +1,59 @@
+package models
+
+import "github.com/google/uuid"
+
+// Entity types that can be searched.
+const (
+	EntityRace    = "race"
+	EntitySubrace = "subrace"
+	EntityTrait   = "trait"
+)
+
+// EntityTypes lists the searchable entity types in the order their results are returned.
+var EntityTypes = []string{EntityRace, EntitySubrace, EntityTrait}
+
+const (
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 50
+)
+
+// HighlightStart and HighlightStop surround the matched words in highlights.
+const (
+	HighlightStart = "<mark>"
+	HighlightStop  = "</mark>"
+)
+
+// SearchQuery is a text search over the given entity types, returning at most Limit hits of each.
+type SearchQuery struct {
+	Text  string
+	Types []string
+	Limit int
+}
+
+// SearchHit is an entity matching a search. Rank adds the full-text rank of the name and description to the
+// trigram similarity of the name, so near misses such as typos still rank. Name and Snippet hold the name and the
+// best fragments of the description with the matched words highlighted. Both are HTML-escaped, so the highlight
+// tags are the only markup in them.
+type SearchHit struct {
+	ID      uuid.UUID  `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RaceID  *uuid.UUID `json:"race_id,omitempty" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name    string     `json:"name" example:"<mark>Hill</mark> <mark>Dwarf</mark>"`
+	Snippet string     `json:"snippet,omitempty"`
+	Rank    float64    `json:"rank" example:"0.87"`
+}
+
+// SearchResults holds the ranked hits of a search grouped by entity type. Subrace hits carry the ID of their race.
+type SearchResults struct {
+	Query    string      `json:"query" example:"dwarf hill"`
+	Races    []SearchHit `json:"races"`
+	Subraces []SearchHit `json:"subraces"`
+	Traits   []SearchHit `json:"traits"`
+}
+
+// Suggestion is a name completing or resembling the text typed so far.
+type Suggestion struct {
+	ID    uuid.UUID `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Type  string    `json:"type" example:"trait"`
+	Name  string    `json:"name" example:"Darkvision"`
+	Score float64   `json:"score" example:"0.8"`
+}
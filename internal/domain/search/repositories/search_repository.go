@@ -0,0 +1,8 @@
+package repositories
+
+import "github.com/Casagrande-Lucas/dnd/internal/domain/search/models"
+
+type SearchRepository interface {
+	Search(entity string, text string, limit int) ([]models.SearchHit, error)
+	Suggest(text string, limit int) ([]models.Suggestion, error)
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/search/models"
+	"gorm.io/gorm"
+)
+
+// searchDocument is the text searched in every table. It must match the expression of the full-text indexes created
+// by the text search migration, or the indexes are not used.
+const searchDocument = "to_tsvector('english', name || ' ' || coalesce(description, ''))"
+
+// ts_headline delimits the matched words with control characters rather than HTML tags, so the text can be
+// HTML-escaped before the delimiters are turned into tags.
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// Options of ts_headline for names, highlighted in full, and for description snippets.
+var (
+	nameHighlight    = fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", highlightStart, highlightStop)
+	snippetHighlight = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MinWords=5, MaxWords=20", highlightStart, highlightStop)
+)
+
+// highlighter turns the delimiters of ts_headline into the highlight tags of HTML-escaped text.
+var highlighter = strings.NewReplacer(highlightStart, models.HighlightStart, highlightStop, models.HighlightStop)
+
+// searchTables maps the entity types to their table and the expression of the race they belong to.
+var searchTables = map[string]struct {
+	table  string
+	raceID string
+}{
+	models.EntityRace:    {table: "races", raceID: "NULL::uuid"},
+	models.EntitySubrace: {table: "subraces", raceID: "race_id"},
+	models.EntityTrait:   {table: "traits", raceID: "NULL::uuid"},
+}
+
+// searchRepositoryGormImpl is a concrete implementation of the SearchRepository interface using GORM.
+type searchRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormSearchRepository creates a new instance of searchRepositoryGormImpl.
+func NewGormSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// Search retrieves the entities of one type whose name and description match the text, best first. Entities whose
+// name is similar to the text match too, so misspelled words still find them.
+func (r *searchRepositoryGormImpl) Search(entity string, text string, limit int) ([]models.SearchHit, error) {
+	source, ok := searchTables[entity]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity type: %s", entity)
+	}
+
+	sql := fmt.Sprintf(`SELECT id, %[2]s AS race_id,
+    ts_headline('english', name, query, ?) AS name,
+    ts_headline('english', coalesce(description, ''), query, ?) AS snippet,
+    ts_rank(%[3]s, query) + word_similarity(?, name) AS rank
+FROM %[1]s, websearch_to_tsquery('english', ?) AS query
+WHERE %[3]s @@ query OR ? <%% name
+ORDER BY rank DESC, %[1]s.name
+LIMIT ?`, source.table, source.raceID, searchDocument)
+
+	var hits []models.SearchHit
+	if err := r.db.Raw(sql, nameHighlight, snippetHighlight, text, text, text, limit).Scan(&hits).Error; err != nil {
+		return nil, err
+	}
+	for i := range hits {
+		hits[i].Name = highlighter.Replace(html.EscapeString(hits[i].Name))
+		hits[i].Snippet = highlighter.Replace(html.EscapeString(hits[i].Snippet))
+	}
+	return hits, nil
+}
+
+// Suggest retrieves the names of races, subraces and traits starting with the text or containing a word similar to
+// it, for autocompletion. Names starting with the text score highest.
+func (r *searchRepositoryGormImpl) Suggest(text string, limit int) ([]models.Suggestion, error) {
+	prefix := likeEscaper.Replace(text) + "%"
+
+	var selects []string
+	var args []any
+	for _, entity := range models.EntityTypes {
+		selects = append(selects, fmt.Sprintf(`SELECT id, '%s' AS type, name,
+    GREATEST(word_similarity(?, name), CASE WHEN name ILIKE ? THEN 1 ELSE 0 END) AS score
+FROM %s
+WHERE ? <%% name OR name ILIKE ?`, entity, searchTables[entity].table))
+		args = append(args, text, prefix, text, prefix)
+	}
+
+	sql := strings.Join(selects, "\nUNION ALL\n") + "\nORDER BY score DESC, name\nLIMIT ?"
+	args = append(args, limit)
+
+	var suggestions []models.Suggestion
+	if err := r.db.Raw(sql, args...).Scan(&suggestions).Error; err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns, whose default escape character is the backslash.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
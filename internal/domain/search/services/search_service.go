@@ -0,0 +1,8 @@
+package services
+
+import "github.com/Casagrande-Lucas/dnd/internal/domain/search/models"
+
+type SearchService interface {
+	Search(params map[string]string) (*models.SearchResults, error)
+	Suggest(params map[string]string) ([]models.Suggestion, error)
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/search/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/search/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+)
+
+// maxSearchText bounds the length of search texts, which are compared with every indexed name.
+const maxSearchText = 200
+
+// searchServiceImpl is the concrete implementation of SearchService.
+type searchServiceImpl struct {
+	repo repositories.SearchRepository
+}
+
+// NewSearchService creates a new instance of searchServiceImpl.
+func NewSearchService(repo repositories.SearchRepository) SearchService {
+	return &searchServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *searchServiceImpl) Search(params map[string]string) (*models.SearchResults, error) {
+	query, err := parseSearchQuery(params, true)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	results := &models.SearchResults{
+		Query:    query.Text,
+		Races:    []models.SearchHit{},
+		Subraces: []models.SearchHit{},
+		Traits:   []models.SearchHit{},
+	}
+	for _, entity := range query.Types {
+		hits, err := s.repo.Search(entity, query.Text, query.Limit)
+		if err != nil {
+			return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to search %ss: %w", entity, err))
+		}
+
+		switch entity {
+		case models.EntityRace:
+			results.Races = append(results.Races, hits...)
+		case models.EntitySubrace:
+			results.Subraces = append(results.Subraces, hits...)
+		case models.EntityTrait:
+			results.Traits = append(results.Traits, hits...)
+		}
+	}
+	return results, nil
+}
+
+func (s *searchServiceImpl) Suggest(params map[string]string) ([]models.Suggestion, error) {
+	query, err := parseSearchQuery(params, false)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, err)
+	}
+
+	suggestions, err := s.repo.Suggest(query.Text, query.Limit)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to suggest names: %w", err))
+	}
+	if suggestions == nil {
+		suggestions = []models.Suggestion{}
+	}
+	return suggestions, nil
+}
+
+func parseSearchQuery(params map[string]string, allowTypes bool) (*models.SearchQuery, error) {
+	query := &models.SearchQuery{
+		Types: models.EntityTypes,
+		Limit: models.DefaultSearchLimit,
+	}
+
+	allowed := "q and limit"
+	if allowTypes {
+		allowed = "q, types and limit"
+	}
+	for key, value := range params {
+		switch {
+		case key == "q":
+			query.Text = strings.Join(strings.Fields(value), " ")
+		case key == "limit":
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > models.MaxSearchLimit {
+				return nil, fmt.Errorf("limit must be a number between 1 and %d: %s", models.MaxSearchLimit, value)
+			}
+			query.Limit = limit
+		case key == "types" && allowTypes:
+			var types []string
+			for _, entity := range strings.Split(value, ",") {
+				entity = strings.TrimSpace(entity)
+				if !slices.Contains(models.EntityTypes, entity) {
+					return nil, fmt.Errorf("unknown type '%s', allowed types are %s", entity, strings.Join(models.EntityTypes, ", "))
+				}
+				if !slices.Contains(types, entity) {
+					types = append(types, entity)
+				}
+			}
+			query.Types = types
+		default:
+			return nil, fmt.Errorf("unknown parameter '%s', allowed parameters are %s", key, allowed)
+		}
+	}
+
+	if query.Text == "" {
+		return nil, errors.New("search text q is required")
+	}
+	if utf8.RuneCountInString(query.Text) > maxSearchText {
+		return nil, fmt.Errorf("search text must be at most %d characters", maxSearchText)
+	}
+	return query, nil
+}
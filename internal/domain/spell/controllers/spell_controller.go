@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SpellController interface {
+	GetAllSpells(ctx *gin.Context)
+	GetSpellByID(ctx *gin.Context)
+	CreateSpell(ctx *gin.Context)
+	UpdateSpell(ctx *gin.Context)
+	DeleteSpell(ctx *gin.Context)
+	GetClassSpells(ctx *gin.Context)
+}
@@ -0,0 +1,229 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// spellControllerGin is a concrete implementation of SpellController using the Gin framework.
+type spellControllerGin struct {
+	service services.SpellService
+}
+
+// NewSpellControllerGin creates a new instance of spellControllerGin.
+func NewSpellControllerGin(service services.SpellService) SpellController {
+	return &spellControllerGin{
+		service: service,
+	}
+}
+
+// GetAllSpells godoc
+// @Summary      List all spells
+// @Description  Return all registered spells ordered by level and name, optionally filtered
+// @Tags         Spells
+// @Accept       json
+// @Produce      json
+// @Param        name               query     string   false  "Case-insensitive name fragment"
+// @Param        level              query     integer  false  "Exact spell level (0 for cantrips)"
+// @Param        min_level          query     integer  false  "Minimum spell level"
+// @Param        max_level          query     integer  false  "Maximum spell level"
+// @Param        school             query     string   false  "School of magic"
+// @Param        class_id           query     string   false  "Only spells on this class's list (UUID)"
+// @Param        casting_time       query     string   false  "Case-insensitive casting time fragment"
+// @Param        concentration      query     bool     false  "Requires concentration"
+// @Param        ritual             query     bool     false  "Can be cast as a ritual"
+// @Param        verbal             query     bool     false  "Has a verbal component"
+// @Param        somatic            query     bool     false  "Has a somatic component"
+// @Param        material           query     bool     false  "Has a material component"
+// @Param        material_consumed  query     bool     false  "Consumes its material component"
+// @Param        costly             query     bool     false  "Has a material component with a listed cost"
+// @Success      200                {array}   models.Spell
+// @Failure      400                {object}  httperror.ErrorResponse
+// @Failure      500                {object}  httperror.ErrorResponse
+// @Router       /spells [get]
+func (c *spellControllerGin) GetAllSpells(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	spells, err := c.service.ListSpells(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, spells)
+}
+
+// GetSpellByID godoc
+// @Summary      Get spell by ID
+// @Description  Retrieve a spell using the provided ID
+// @Tags         Spells
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Spell ID (UUID)"
+// @Success      200  {object}  models.Spell
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /spells/{id} [get]
+func (c *spellControllerGin) GetSpellByID(ctx *gin.Context) {
+	id, err := parseSpellID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	spell, err := c.service.GetSpellDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, spell)
+}
+
+// CreateSpell godoc
+// @Summary      Create spell
+// @Description  Create a new spell
+// @Tags         Spells
+// @Accept       json
+// @Produce      json
+// @Param        spell  body      models.Spell  true  "Spell info"
+// @Success      201          {object}  models.Spell
+// @Failure      400          {object}  httperror.ErrorResponse
+// @Failure      409          {object}  httperror.ErrorResponse
+// @Failure      500          {object}  httperror.ErrorResponse
+// @Router       /spells [post]
+func (c *spellControllerGin) CreateSpell(ctx *gin.Context) {
+	var spell models.Spell
+	if err := ctx.ShouldBindJSON(&spell); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterSpell(&spell); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, spell)
+}
+
+// UpdateSpell godoc
+// @Summary      Update spell
+// @Description  Update an existing spell
+// @Tags         Spells
+// @Accept       json
+// @Produce      json
+// @Param        id           path      string              true  "Spell ID (UUID)"
+// @Param        spell  body      models.Spell  true  "Spell info"
+// @Success      200          {object}  models.Spell
+// @Failure      400          {object}  httperror.ErrorResponse
+// @Failure      404          {object}  httperror.ErrorResponse
+// @Failure      409          {object}  httperror.ErrorResponse
+// @Router       /spells/{id} [put]
+func (c *spellControllerGin) UpdateSpell(ctx *gin.Context) {
+	id, err := parseSpellID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var spell models.Spell
+	if err := ctx.ShouldBindJSON(&spell); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateSpellInfo(id, &spell); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, spell)
+}
+
+// DeleteSpell godoc
+// @Summary      Delete spell
+// @Description  Delete an existing spell and remove it from every class list
+// @Tags         Spells
+// @Param        id   path  string  true  "Spell ID (UUID)"
+// @Success      204
+// @Failure      400 {object} httperror.ErrorResponse
+// @Failure      404 {object} httperror.ErrorResponse
+// @Router       /spells/{id} [delete]
+func (c *spellControllerGin) DeleteSpell(ctx *gin.Context) {
+	id, err := parseSpellID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveSpell(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetClassSpells godoc
+// @Summary      List spells a class can learn
+// @Description  Return the spells on a class's list that a character of the given class level can learn, cantrips included
+// @Tags         Spells
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string   true  "Class ID (UUID)"
+// @Param        level  query     integer  true  "Class level (1-20)"
+// @Success      200    {object}  models.ClassSpellList
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Router       /classes/{id}/spells [get]
+func (c *spellControllerGin) GetClassSpells(ctx *gin.Context) {
+	classID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid class ID: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	level, err := strconv.Atoi(ctx.Query("level"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid level: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	spells, err := c.service.ListClassSpells(classID, level)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, spells)
+}
+
+// parseSpellID reads the spell ID path parameter.
+func parseSpellID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell ID: %w", err))
+	}
+	return id, nil
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/google/uuid"
+)
+
+const (
+	SchoolAbjuration    = "abjuration"
+	SchoolConjuration   = "conjuration"
+	SchoolDivination    = "divination"
+	SchoolEnchantment   = "enchantment"
+	SchoolEvocation     = "evocation"
+	SchoolIllusion      = "illusion"
+	SchoolNecromancy    = "necromancy"
+	SchoolTransmutation = "transmutation"
+)
+
+// Schools lists the schools of magic in alphabetical order.
+var Schools = []string{
+	SchoolAbjuration,
+	SchoolConjuration,
+	SchoolDivination,
+	SchoolEnchantment,
+	SchoolEvocation,
+	SchoolIllusion,
+	SchoolNecromancy,
+	SchoolTransmutation,
+}
+
+// MaxSpellLevel is the highest spell level. Cantrips are level 0.
+const MaxSpellLevel = 9
+
+type Spell struct {
+	ID            uuid.UUID       `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name          string          `json:"name" gorm:"unique;not null" example:"Fireball"`
+	Level         int             `json:"level" gorm:"not null;index" example:"3"`
+	School        string          `json:"school" gorm:"not null;index" enums:"abjuration,conjuration,divination,enchantment,evocation,illusion,necromancy,transmutation" example:"evocation"`
+	CastingTime   string          `json:"casting_time" example:"1 action"`
+	Range         string          `json:"range" example:"150 feet"`
+	Components    SpellComponents `json:"components" gorm:"embedded;embeddedPrefix:component_"`
+	Duration      string          `json:"duration" example:"Instantaneous"`
+	Concentration bool            `json:"concentration"`
+	Ritual        bool            `json:"ritual"`
+	Description   string          `json:"description"`
+	HigherLevels  []SpellScaling  `json:"higher_levels,omitempty" gorm:"type:jsonb;serializer:json"`
+	Classes       []models.Class  `json:"classes,omitempty" gorm:"many2many:spell_classes;constraint:OnDelete:CASCADE;"`
+}
+
+// SpellComponents lists the verbal, somatic and material components of a spell. MaterialCost is in copper pieces
+// and is only set for components with a listed price.
+type SpellComponents struct {
+	Verbal           bool   `json:"verbal"`
+	Somatic          bool   `json:"somatic"`
+	Material         bool   `json:"material"`
+	Materials        string `json:"materials,omitempty" example:"a tiny ball of bat guano and sulfur"`
+	MaterialCost     int    `json:"material_cost,omitempty" example:"0"`
+	MaterialConsumed bool   `json:"material_consumed,omitempty"`
+}
+
+// SpellScaling is how a spell improves when cast with a higher slot (SlotLevel) or, for cantrips, once the caster
+// reaches CharacterLevel. Damage is dice notation.
+type SpellScaling struct {
+	SlotLevel      int    `json:"slot_level,omitempty" example:"4"`
+	CharacterLevel int    `json:"character_level,omitempty"`
+	Damage         string `json:"damage,omitempty" example:"9d6"`
+	Description    string `json:"description,omitempty"`
+}
+
+// ClassSpellList is what a class can learn at a given class level.
+type ClassSpellList struct {
+	ClassID       uuid.UUID `json:"class_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Level         int       `json:"level" example:"5"`
+	MaxSpellLevel int       `json:"max_spell_level" example:"3"`
+	Spells        []*Spell  `json:"spells"`
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	"github.com/google/uuid"
+)
+
+type SpellRepository interface {
+	GetAllSpells(criteria map[string]string) ([]*models.Spell, error)
+	GetSpellByID(id uuid.UUID) (*models.Spell, error)
+	GetSpellByName(name string) (*models.Spell, error)
+	CreateSpell(spell *models.Spell) error
+	UpdateSpell(id uuid.UUID, spell *models.Spell) error
+	DeleteSpell(id uuid.UUID) error
+	GetSpellsForClass(classID uuid.UUID, maxLevel int) ([]*models.Spell, error)
+}
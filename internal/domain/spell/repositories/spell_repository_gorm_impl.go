@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// spellRepositoryGormImpl is a concrete implementation of the SpellRepository interface using GORM.
+type spellRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormSpellRepository creates a new instance of spellRepositoryGormImpl.
+func NewGormSpellRepository(db *gorm.DB) SpellRepository {
+	return &spellRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// spellFilters maps boolean filters to the column they test.
+var spellFilters = map[string]string{
+	"concentration":     "concentration",
+	"ritual":            "ritual",
+	"verbal":            "component_verbal",
+	"somatic":           "component_somatic",
+	"material":          "component_material",
+	"material_consumed": "component_material_consumed",
+}
+
+// GetAllSpells retrieves the spells matching the given criteria ordered by level and name. Criteria values are
+// expected to be validated by the caller.
+func (r *spellRepositoryGormImpl) GetAllSpells(criteria map[string]string) ([]*models.Spell, error) {
+	var spells []*models.Spell
+	query := r.db.Preload("Classes").Order("level").Order("name")
+
+	for key, value := range criteria {
+		switch key {
+		case "name":
+			query = query.Where("name ILIKE ?", "%"+value+"%")
+		case "level":
+			query = query.Where("level = ?", value)
+		case "min_level":
+			query = query.Where("level >= ?", value)
+		case "max_level":
+			query = query.Where("level <= ?", value)
+		case "school":
+			query = query.Where("school = ?", value)
+		case "casting_time":
+			query = query.Where("casting_time ILIKE ?", "%"+value+"%")
+		case "class_id":
+			query = query.Where("id IN (?)", r.db.Table("spell_classes").Select("spell_id").Where("class_id = ?", value))
+		case "costly":
+			if value == "true" {
+				query = query.Where("component_material_cost > 0")
+			} else {
+				query = query.Where("component_material_cost = 0")
+			}
+		default:
+			column, ok := spellFilters[key]
+			if !ok {
+				return nil, fmt.Errorf("unknown spell filter: %s", key)
+			}
+			query = query.Where(column+" = ?", value == "true")
+		}
+	}
+
+	if err := query.Find(&spells).Error; err != nil {
+		return nil, err
+	}
+	return spells, nil
+}
+
+// GetSpellByID retrieves a spell by its ID, including its class lists.
+func (r *spellRepositoryGormImpl) GetSpellByID(id uuid.UUID) (*models.Spell, error) {
+	var spell models.Spell
+	if err := r.db.Preload("Classes").First(&spell, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("spell with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &spell, nil
+}
+
+// GetSpellByName retrieves a spell by its name, including its class lists.
+func (r *spellRepositoryGormImpl) GetSpellByName(name string) (*models.Spell, error) {
+	var spell models.Spell
+	if err := r.db.Preload("Classes").Where("name = ?", name).First(&spell).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("spell with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &spell, nil
+}
+
+// CreateSpell adds a new spell to the database and links it to the given classes.
+func (r *spellRepositoryGormImpl) CreateSpell(spell *models.Spell) error {
+	return r.db.Omit("Classes.*").Create(spell).Error
+}
+
+// UpdateSpell updates an existing spell's details in the database, replacing its class lists.
+func (r *spellRepositoryGormImpl) UpdateSpell(id uuid.UUID, spell *models.Spell) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var existingSpell models.Spell
+	if err := tx.First(&existingSpell, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("spell with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	spell.ID = existingSpell.ID
+
+	if err := tx.Model(&existingSpell).Omit("Classes.*").Association("Classes").Replace(spell.Classes); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Omit("Classes").Save(spell).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// DeleteSpell removes a spell from the database.
+func (r *spellRepositoryGormImpl) DeleteSpell(id uuid.UUID) error {
+	result := r.db.Delete(&models.Spell{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("spell with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
+
+// GetSpellsForClass retrieves the spells on a class's list up to the given spell level, cantrips first.
+func (r *spellRepositoryGormImpl) GetSpellsForClass(classID uuid.UUID, maxLevel int) ([]*models.Spell, error) {
+	var spells []*models.Spell
+	if err := r.db.Preload("Classes").
+		Joins("JOIN spell_classes ON spell_classes.spell_id = spells.id").
+		Where("spell_classes.class_id = ? AND spells.level <= ?", classID, maxLevel).
+		Order("spells.level").
+		Order("spells.name").
+		Find(&spells).Error; err != nil {
+		return nil, err
+	}
+	return spells, nil
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	"github.com/google/uuid"
+)
+
+type SpellService interface {
+	ListSpells(criteria map[string]string) ([]*models.Spell, error)
+	GetSpellDetails(id uuid.UUID) (*models.Spell, error)
+	RegisterSpell(spell *models.Spell) error
+	UpdateSpellInfo(id uuid.UUID, spell *models.Spell) error
+	RemoveSpell(id uuid.UUID) error
+	ListClassSpells(classID uuid.UUID, level int) (*models.ClassSpellList, error)
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	classServices "github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spell/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/dice"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// spellServiceImpl is the concrete implementation of SpellService.
+type spellServiceImpl struct {
+	repo         repositories.SpellRepository
+	classService classServices.ClassService
+}
+
+// NewSpellService creates a new instance of spellServiceImpl.
+func NewSpellService(repo repositories.SpellRepository, classService classServices.ClassService) SpellService {
+	return &spellServiceImpl{
+		repo:         repo,
+		classService: classService,
+	}
+}
+
+func (s *spellServiceImpl) ListSpells(criteria map[string]string) ([]*models.Spell, error) {
+	if err := validateCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell filter: %w", err))
+	}
+
+	spells, err := s.repo.GetAllSpells(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list spells: %w", err))
+	}
+	return spells, nil
+}
+
+func (s *spellServiceImpl) GetSpellDetails(id uuid.UUID) (*models.Spell, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell ID: %s", id.String()))
+	}
+
+	spell, err := s.repo.GetSpellByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get spell details by ID: %w", err))
+	}
+	return spell, nil
+}
+
+func (s *spellServiceImpl) RegisterSpell(spell *models.Spell) error {
+	if err := validateSpell(spell); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell data: %w", err))
+	}
+
+	if err := s.checkClasses(spell); err != nil {
+		return err
+	}
+
+	existingSpell, _ := s.repo.GetSpellByName(spell.Name)
+	if existingSpell != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("spell with name '%s' already exists", spell.Name))
+	}
+
+	if err := s.repo.CreateSpell(spell); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register spell: %w", err))
+	}
+	return nil
+}
+
+func (s *spellServiceImpl) UpdateSpellInfo(id uuid.UUID, spell *models.Spell) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell ID: %s", id.String()))
+	}
+
+	if err := validateSpell(spell); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell data: %w", err))
+	}
+
+	if err := s.checkClasses(spell); err != nil {
+		return err
+	}
+
+	duplicateSpell, _ := s.repo.GetSpellByName(spell.Name)
+	if duplicateSpell != nil && duplicateSpell.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("spell with name '%s' already exists", spell.Name))
+	}
+
+	if err := s.repo.UpdateSpell(id, spell); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update spell info: %w", err))
+	}
+	return nil
+}
+
+func (s *spellServiceImpl) RemoveSpell(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteSpell(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove spell: %w", err))
+	}
+	return nil
+}
+
+func (s *spellServiceImpl) ListClassSpells(classID uuid.UUID, level int) (*models.ClassSpellList, error) {
+	if level < 1 || level > classModels.MaxLevel {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("level must be between 1 and %d: %d", classModels.MaxLevel, level))
+	}
+
+	class, err := s.classService.GetClassDetails(classID)
+	if err != nil {
+		return nil, err
+	}
+	if class.Spellcasting.CasterType == classModels.CasterTypeNone {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("class %s does not cast spells", class.Name))
+	}
+
+	maxSpellLevel := class.Spellcasting.MaxSpellLevel(level)
+	spells, err := s.repo.GetSpellsForClass(classID, maxSpellLevel)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get class spells: %w", err))
+	}
+
+	return &models.ClassSpellList{
+		ClassID:       class.ID,
+		Level:         level,
+		MaxSpellLevel: maxSpellLevel,
+		Spells:        spells,
+	}, nil
+}
+
+// checkClasses makes sure every class on the spell's lists exists and casts spells.
+func (s *spellServiceImpl) checkClasses(spell *models.Spell) error {
+	for _, ref := range spell.Classes {
+		class, err := s.classService.GetClassDetails(ref.ID)
+		if err != nil {
+			if errors.Is(err, failure.ErrorNotFound) {
+				return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("class with ID %s does not exist", ref.ID.String()))
+			}
+			return err
+		}
+		if class.Spellcasting.CasterType == classModels.CasterTypeNone {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("class %s does not cast spells", class.Name))
+		}
+	}
+	return nil
+}
+
+func validateCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "level", "min_level", "max_level":
+			level, err := strconv.Atoi(value)
+			if err != nil || level < 0 || level > models.MaxSpellLevel {
+				return fmt.Errorf("%s must be between 0 and %d: %s", key, models.MaxSpellLevel, value)
+			}
+		case "school":
+			if !slices.Contains(models.Schools, value) {
+				return fmt.Errorf("invalid school: %s", value)
+			}
+		case "class_id":
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("invalid class ID: %w", err)
+			}
+		case "concentration", "ritual", "verbal", "somatic", "material", "material_consumed", "costly":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("%s must be true or false: %s", key, value)
+			}
+		case "name", "casting_time":
+		default:
+			return fmt.Errorf("unknown filter '%s', allowed filters are name, level, min_level, max_level, school, class_id, casting_time, concentration, ritual, verbal, somatic, material, material_consumed and costly", key)
+		}
+	}
+	return nil
+}
+
+func validateSpell(spell *models.Spell) error {
+	if spell.Name == "" {
+		return errors.New("spell name cannot be empty")
+	}
+	if spell.Level < 0 || spell.Level > models.MaxSpellLevel {
+		return fmt.Errorf("spell level must be between 0 and %d: %d", models.MaxSpellLevel, spell.Level)
+	}
+	if !slices.Contains(models.Schools, spell.School) {
+		return fmt.Errorf("invalid school: %s", spell.School)
+	}
+	if spell.CastingTime == "" {
+		return errors.New("casting time cannot be empty")
+	}
+	if spell.Duration == "" {
+		return errors.New("duration cannot be empty")
+	}
+
+	components := spell.Components
+	if !components.Verbal && !components.Somatic && !components.Material {
+		return errors.New("a spell needs at least one verbal, somatic or material component")
+	}
+	if !components.Material && (components.Materials != "" || components.MaterialCost != 0 || components.MaterialConsumed) {
+		return errors.New("material details are only allowed with a material component")
+	}
+	if components.Material && components.Materials == "" {
+		return errors.New("material component must describe its materials")
+	}
+	if components.MaterialCost < 0 {
+		return fmt.Errorf("material cost cannot be negative: %d", components.MaterialCost)
+	}
+
+	for _, scaling := range spell.HigherLevels {
+		if err := validateScaling(spell, scaling); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, class := range spell.Classes {
+		if seen[class.ID] {
+			return fmt.Errorf("duplicate class on spell list: %s", class.ID.String())
+		}
+		seen[class.ID] = true
+	}
+
+	return nil
+}
+
+func validateScaling(spell *models.Spell, scaling models.SpellScaling) error {
+	if spell.Level == 0 {
+		if scaling.SlotLevel != 0 {
+			return errors.New("cantrips cannot be cast with a higher slot")
+		}
+		if scaling.CharacterLevel < 1 || scaling.CharacterLevel > classModels.MaxLevel {
+			return fmt.Errorf("cantrip scaling character level must be between 1 and %d: %d", classModels.MaxLevel, scaling.CharacterLevel)
+		}
+	} else {
+		if scaling.CharacterLevel != 0 {
+			return errors.New("only cantrips scale with character level")
+		}
+		if scaling.SlotLevel <= spell.Level || scaling.SlotLevel > models.MaxSpellLevel {
+			return fmt.Errorf("scaling slot level must be between %d and %d: %d", spell.Level+1, models.MaxSpellLevel, scaling.SlotLevel)
+		}
+	}
+
+	if scaling.Damage == "" && scaling.Description == "" {
+		return errors.New("higher level scaling needs a damage expression or a description")
+	}
+	if scaling.Damage != "" {
+		if _, err := dice.Parse(scaling.Damage); err != nil {
+			return fmt.Errorf("invalid scaling damage: %w", err)
+		}
+	}
+	return nil
+}
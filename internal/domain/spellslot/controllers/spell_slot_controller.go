@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type SpellSlotController interface {
+	GetAllTrackers(ctx *gin.Context)
+	GetTrackerByID(ctx *gin.Context)
+	CreateTracker(ctx *gin.Context)
+	UpdateTracker(ctx *gin.Context)
+	DeleteTracker(ctx *gin.Context)
+	ConsumeSlot(ctx *gin.Context)
+	RestoreSlots(ctx *gin.Context)
+	ShortRest(ctx *gin.Context)
+	LongRest(ctx *gin.Context)
+}
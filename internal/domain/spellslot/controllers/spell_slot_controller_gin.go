@@ -0,0 +1,307 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// spellSlotControllerGin is a concrete implementation of SpellSlotController using the Gin framework.
+type spellSlotControllerGin struct {
+	service services.SpellSlotService
+}
+
+// NewSpellSlotControllerGin creates a new instance of spellSlotControllerGin.
+func NewSpellSlotControllerGin(service services.SpellSlotService) SpellSlotController {
+	return &spellSlotControllerGin{
+		service: service,
+	}
+}
+
+// GetAllTrackers godoc
+// @Summary      List spell slot trackers
+// @Description  Retrieve spell slot trackers, optionally only the one of an owner
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        owner_id  query     string  false  "Owner ID (UUID)"
+// @Success      200       {array}   models.SpellSlotTracker
+// @Failure      400       {object}  httperror.ErrorResponse
+// @Failure      500       {object}  httperror.ErrorResponse
+// @Router       /spell-slots [get]
+func (c *spellSlotControllerGin) GetAllTrackers(ctx *gin.Context) {
+	criteria := make(map[string]string)
+	for key, values := range ctx.Request.URL.Query() {
+		if len(values) > 0 {
+			criteria[key] = values[0]
+		}
+	}
+
+	trackers, err := c.service.ListTrackers(criteria)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, trackers)
+}
+
+// GetTrackerByID godoc
+// @Summary      Get spell slot tracker by ID
+// @Description  Retrieve a spell slot tracker using the provided ID
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Spell slot tracker ID (UUID)"
+// @Success      200  {object}  models.SpellSlotTracker
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id} [get]
+func (c *spellSlotControllerGin) GetTrackerByID(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.GetTracker(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, tracker)
+}
+
+// CreateTracker godoc
+// @Summary      Create spell slot tracker
+// @Description  Start tracking the spell slots of an owner, from explicit slot counts or from caster and pact levels. Each owner has at most one tracker.
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        tracker  body      models.SpellSlotTrackerRequest  true  "Maximum slots"
+// @Success      201      {object}  models.SpellSlotTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Failure      500      {object}  httperror.ErrorResponse
+// @Router       /spell-slots [post]
+func (c *spellSlotControllerGin) CreateTracker(ctx *gin.Context) {
+	var request models.SpellSlotTrackerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.CreateTracker(&request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, tracker)
+}
+
+// UpdateTracker godoc
+// @Summary      Update spell slot tracker
+// @Description  Replace the maximum slots of a tracker, for example after a level up. Spent slots stay spent up to the new maximum.
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                          true  "Spell slot tracker ID (UUID)"
+// @Param        tracker  body      models.SpellSlotTrackerRequest  true  "Maximum slots"
+// @Success      200      {object}  models.SpellSlotTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id} [put]
+func (c *spellSlotControllerGin) UpdateTracker(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.SpellSlotTrackerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.UpdateTrackerSlots(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, tracker)
+}
+
+// DeleteTracker godoc
+// @Summary      Delete spell slot tracker
+// @Description  Stop tracking spell slots
+// @Tags         Spell Slots
+// @Param        id   path      string  true  "Spell slot tracker ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id} [delete]
+func (c *spellSlotControllerGin) DeleteTracker(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveTracker(id); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// ConsumeSlot godoc
+// @Summary      Consume spell slot
+// @Description  Spend a slot to cast a spell, either at the spell's level, at a higher slot level, or with the lowest higher slot left when upcasting is allowed. Fails with 409 when no suitable slot is left.
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                     true  "Spell slot tracker ID (UUID)"
+// @Param        request  body      models.ConsumeSlotRequest  true  "Spell to cast"
+// @Success      200      {object}  models.SlotConsumption
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Failure      409      {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id}/consume [post]
+func (c *spellSlotControllerGin) ConsumeSlot(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.ConsumeSlotRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	consumption, err := c.service.ConsumeSlot(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, consumption)
+}
+
+// RestoreSlots godoc
+// @Summary      Restore spell slots
+// @Description  Recover spent slots of one level, or pact magic slots, such as with Arcane Recovery
+// @Tags         Spell Slots
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                     true  "Spell slot tracker ID (UUID)"
+// @Param        request  body      models.RestoreSlotRequest  true  "Slots to recover"
+// @Success      200      {object}  models.SpellSlotTracker
+// @Failure      400      {object}  httperror.ErrorResponse
+// @Failure      404      {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id}/restore [post]
+func (c *spellSlotControllerGin) RestoreSlots(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var request models.RestoreSlotRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.RestoreSlots(id, &request)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, tracker)
+}
+
+// ShortRest godoc
+// @Summary      Short rest
+// @Description  Recover every pact magic slot
+// @Tags         Spell Slots
+// @Produce      json
+// @Param        id   path      string  true  "Spell slot tracker ID (UUID)"
+// @Success      200  {object}  models.SpellSlotTracker
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id}/rest/short [post]
+func (c *spellSlotControllerGin) ShortRest(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.ShortRest(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, tracker)
+}
+
+// LongRest godoc
+// @Summary      Long rest
+// @Description  Recover every spell slot and pact magic slot
+// @Tags         Spell Slots
+// @Produce      json
+// @Param        id   path      string  true  "Spell slot tracker ID (UUID)"
+// @Success      200  {object}  models.SpellSlotTracker
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /spell-slots/{id}/rest/long [post]
+func (c *spellSlotControllerGin) LongRest(ctx *gin.Context) {
+	id, err := parseTrackerID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	tracker, err := c.service.LongRest(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, tracker)
+}
+
+// parseTrackerID reads the spell slot tracker ID path parameter.
+func parseTrackerID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker ID: %w", err))
+	}
+	return id, nil
+}
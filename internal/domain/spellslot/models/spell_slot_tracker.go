@@ -0,0 +1,174 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSpellLevel is the highest spell level a slot can have.
+const MaxSpellLevel = 9
+
+// ErrNoSlotAvailable is returned when every slot that could pay for a spell has already been spent.
+var ErrNoSlotAvailable = errors.New("no spell slot available")
+
+// SpellSlotTracker tracks the spell slots an owner, usually a character, has left.
+type SpellSlotTracker struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;default:gen_random_uuid();primaryKey" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	OwnerID   uuid.UUID  `json:"owner_id" gorm:"type:uuid;not null;uniqueIndex" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slots     []SlotPool `json:"slots" gorm:"type:jsonb;serializer:json"`
+	PactMagic PactMagic  `json:"pact_magic" gorm:"embedded;embeddedPrefix:pact_"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// SlotPool holds the spell slots of one spell level.
+type SlotPool struct {
+	Level int `json:"level" example:"3"`
+	Max   int `json:"max" example:"3"`
+	Used  int `json:"used" example:"1"`
+}
+
+// PactMagic holds the pact magic slots of a pact caster, all of the same spell level and recovered on a short rest.
+type PactMagic struct {
+	Slots     int `json:"slots" gorm:"not null;default:0" example:"2"`
+	SlotLevel int `json:"slot_level" gorm:"not null;default:0" example:"3"`
+	Used      int `json:"used" gorm:"not null;default:0" example:"0"`
+}
+
+// SpellSlotTrackerRequest sets the maximum slots of a tracker. Slots lists the slot count of each spell level
+// starting at level 1; when it is empty, CasterLevel fills it from the spellcaster table. Likewise PactLevel, the
+// pact caster's class level, fills pact magic when PactSlots is zero.
+type SpellSlotTrackerRequest struct {
+	OwnerID       uuid.UUID `json:"owner_id" binding:"required" swaggertype:"string" format:"uuid"`
+	Slots         []int     `json:"slots,omitempty" example:"4,3,2"`
+	CasterLevel   int       `json:"caster_level,omitempty" example:"5"`
+	PactSlots     int       `json:"pact_slots,omitempty" example:"2"`
+	PactSlotLevel int       `json:"pact_slot_level,omitempty" example:"3"`
+	PactLevel     int       `json:"pact_level,omitempty" example:"5"`
+}
+
+// ConsumeSlotRequest spends a slot to cast a spell. SlotLevel casts the spell with a higher slot; without it the
+// spell uses a slot of its own level, or with Upcast the lowest higher slot left once those run out. Pact spends a
+// pact magic slot instead, always at the pact slot level.
+type ConsumeSlotRequest struct {
+	SpellLevel int  `json:"spell_level" binding:"required" example:"1"`
+	SlotLevel  int  `json:"slot_level,omitempty" example:"2"`
+	Upcast     bool `json:"upcast,omitempty"`
+	Pact       bool `json:"pact,omitempty"`
+}
+
+// RestoreSlotRequest recovers spent slots of one level, or pact magic slots when Pact is set.
+type RestoreSlotRequest struct {
+	Level int  `json:"level,omitempty" example:"2"`
+	Count int  `json:"count,omitempty" example:"1"`
+	Pact  bool `json:"pact,omitempty"`
+}
+
+// SlotConsumption reports the slot spent on a spell and the tracker afterwards.
+type SlotConsumption struct {
+	SpellLevel int               `json:"spell_level" example:"1"`
+	SlotLevel  int               `json:"slot_level" example:"2"`
+	Pact       bool              `json:"pact"`
+	Tracker    *SpellSlotTracker `json:"tracker"`
+}
+
+// Available returns the slots of the pool that are left.
+func (p SlotPool) Available() int {
+	return p.Max - p.Used
+}
+
+// Available returns the pact magic slots that are left.
+func (p PactMagic) Available() int {
+	return p.Slots - p.Used
+}
+
+// SetMaximums replaces the maximum slots of each level, starting at level 1, and of pact magic. Slots already spent
+// stay spent, up to the new maximum.
+func (t *SpellSlotTracker) SetMaximums(slots []int, pactSlots int, pactSlotLevel int) {
+	used := make(map[int]int, len(t.Slots))
+	for _, pool := range t.Slots {
+		used[pool.Level] = pool.Used
+	}
+
+	t.Slots = make([]SlotPool, 0, len(slots))
+	for i, max := range slots {
+		level := i + 1
+		t.Slots = append(t.Slots, SlotPool{Level: level, Max: max, Used: min(used[level], max)})
+	}
+	t.PactMagic = PactMagic{Slots: pactSlots, SlotLevel: pactSlotLevel, Used: min(t.PactMagic.Used, pactSlots)}
+}
+
+// Consume spends a slot as described by ConsumeSlotRequest and returns the level of the slot spent.
+func (t *SpellSlotTracker) Consume(request *ConsumeSlotRequest) (int, error) {
+	if request.Pact {
+		if t.PactMagic.SlotLevel < request.SpellLevel {
+			return 0, fmt.Errorf("pact magic slots are level %d, too low for a level %d spell", t.PactMagic.SlotLevel, request.SpellLevel)
+		}
+		if t.PactMagic.Available() < 1 {
+			return 0, fmt.Errorf("pact magic: %w", ErrNoSlotAvailable)
+		}
+		t.PactMagic.Used++
+		return t.PactMagic.SlotLevel, nil
+	}
+
+	if request.SlotLevel != 0 {
+		pool := t.pool(request.SlotLevel)
+		if pool == nil || pool.Available() < 1 {
+			return 0, fmt.Errorf("level %d: %w", request.SlotLevel, ErrNoSlotAvailable)
+		}
+		pool.Used++
+		return pool.Level, nil
+	}
+
+	for i := range t.Slots {
+		pool := &t.Slots[i]
+		if pool.Level < request.SpellLevel || pool.Available() < 1 {
+			continue
+		}
+		if pool.Level > request.SpellLevel && !request.Upcast {
+			break
+		}
+		pool.Used++
+		return pool.Level, nil
+	}
+	return 0, fmt.Errorf("level %d: %w", request.SpellLevel, ErrNoSlotAvailable)
+}
+
+// Restore recovers up to Count spent slots as described by RestoreSlotRequest. Slots that were never spent cannot
+// be recovered.
+func (t *SpellSlotTracker) Restore(request *RestoreSlotRequest) {
+	if request.Pact {
+		t.PactMagic.Used -= min(request.Count, t.PactMagic.Used)
+		return
+	}
+
+	if pool := t.pool(request.Level); pool != nil {
+		pool.Used -= min(request.Count, pool.Used)
+	}
+}
+
+// ShortRest recovers every pact magic slot.
+func (t *SpellSlotTracker) ShortRest() {
+	t.PactMagic.Used = 0
+}
+
+// LongRest recovers every slot.
+func (t *SpellSlotTracker) LongRest() {
+	for i := range t.Slots {
+		t.Slots[i].Used = 0
+	}
+	t.PactMagic.Used = 0
+}
+
+// pool returns the slots of the given spell level, or nil when the tracker has none of that level.
+func (t *SpellSlotTracker) pool(level int) *SlotPool {
+	for i := range t.Slots {
+		if t.Slots[i].Level == level {
+			return &t.Slots[i]
+		}
+	}
+	return nil
+}
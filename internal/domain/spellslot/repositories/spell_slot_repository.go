@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
+	"github.com/google/uuid"
+)
+
+type SpellSlotRepository interface {
+	GetAllTrackers(criteria map[string]string) ([]*models.SpellSlotTracker, error)
+	GetTrackerByID(id uuid.UUID) (*models.SpellSlotTracker, error)
+	GetTrackerByOwnerID(ownerID uuid.UUID) (*models.SpellSlotTracker, error)
+	CreateTracker(tracker *models.SpellSlotTracker) error
+	UpdateTracker(id uuid.UUID, update func(tracker *models.SpellSlotTracker) error) (*models.SpellSlotTracker, error)
+	DeleteTracker(id uuid.UUID) error
+}
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// spellSlotRepositoryGormImpl is a concrete implementation of the SpellSlotRepository interface using GORM.
+type spellSlotRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormSpellSlotRepository creates a new instance of spellSlotRepositoryGormImpl.
+func NewGormSpellSlotRepository(db *gorm.DB) SpellSlotRepository {
+	return &spellSlotRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllTrackers retrieves the spell slot trackers matching the given criteria. Criteria values are expected to be
+// validated by the caller.
+func (r *spellSlotRepositoryGormImpl) GetAllTrackers(criteria map[string]string) ([]*models.SpellSlotTracker, error) {
+	var trackers []*models.SpellSlotTracker
+	query := r.db.Order("created_at")
+
+	for key, value := range criteria {
+		switch key {
+		case "owner_id":
+			query = query.Where("owner_id = ?", value)
+		default:
+			return nil, fmt.Errorf("unknown spell slot tracker filter: %s", key)
+		}
+	}
+
+	if err := query.Find(&trackers).Error; err != nil {
+		return nil, err
+	}
+	return trackers, nil
+}
+
+// GetTrackerByID retrieves a spell slot tracker by its ID.
+func (r *spellSlotRepositoryGormImpl) GetTrackerByID(id uuid.UUID) (*models.SpellSlotTracker, error) {
+	var tracker models.SpellSlotTracker
+	if err := r.db.First(&tracker, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("spell slot tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// GetTrackerByOwnerID retrieves the spell slot tracker of an owner.
+func (r *spellSlotRepositoryGormImpl) GetTrackerByOwnerID(ownerID uuid.UUID) (*models.SpellSlotTracker, error) {
+	var tracker models.SpellSlotTracker
+	if err := r.db.First(&tracker, "owner_id = ?", ownerID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("spell slot tracker of owner %s %w", ownerID.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// CreateTracker adds a new spell slot tracker to the database.
+func (r *spellSlotRepositoryGormImpl) CreateTracker(tracker *models.SpellSlotTracker) error {
+	return r.db.Create(tracker).Error
+}
+
+// UpdateTracker locks a spell slot tracker, applies update to it and saves the result in a single transaction, so
+// concurrent updates of the same tracker are applied one after another and a slot is never spent twice. Nothing is
+// saved when update fails.
+func (r *spellSlotRepositoryGormImpl) UpdateTracker(id uuid.UUID, update func(tracker *models.SpellSlotTracker) error) (*models.SpellSlotTracker, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var tracker models.SpellSlotTracker
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&tracker, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("spell slot tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+
+	if err := update(&tracker); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Save(&tracker).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return &tracker, nil
+}
+
+// DeleteTracker removes a spell slot tracker from the database.
+func (r *spellSlotRepositoryGormImpl) DeleteTracker(id uuid.UUID) error {
+	result := r.db.Delete(&models.SpellSlotTracker{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("spell slot tracker with ID %s %w", id.String(), failure.ErrorNotFound)
+	}
+	return nil
+}
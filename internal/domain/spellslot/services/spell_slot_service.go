@@ -0,0 +1,18 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
+	"github.com/google/uuid"
+)
+
+type SpellSlotService interface {
+	ListTrackers(criteria map[string]string) ([]*models.SpellSlotTracker, error)
+	GetTracker(id uuid.UUID) (*models.SpellSlotTracker, error)
+	CreateTracker(request *models.SpellSlotTrackerRequest) (*models.SpellSlotTracker, error)
+	UpdateTrackerSlots(id uuid.UUID, request *models.SpellSlotTrackerRequest) (*models.SpellSlotTracker, error)
+	RemoveTracker(id uuid.UUID) error
+	ConsumeSlot(id uuid.UUID, request *models.ConsumeSlotRequest) (*models.SlotConsumption, error)
+	RestoreSlots(id uuid.UUID, request *models.RestoreSlotRequest) (*models.SpellSlotTracker, error)
+	ShortRest(id uuid.UUID) (*models.SpellSlotTracker, error)
+	LongRest(id uuid.UUID) (*models.SpellSlotTracker, error)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	classModels "github.com/Casagrande-Lucas/dnd/internal/domain/class/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// spellSlotServiceImpl is the concrete implementation of SpellSlotService.
+type spellSlotServiceImpl struct {
+	repo repositories.SpellSlotRepository
+}
+
+// NewSpellSlotService creates a new instance of spellSlotServiceImpl.
+func NewSpellSlotService(repo repositories.SpellSlotRepository) SpellSlotService {
+	return &spellSlotServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *spellSlotServiceImpl) ListTrackers(criteria map[string]string) ([]*models.SpellSlotTracker, error) {
+	if err := validateCriteria(criteria); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker filter: %w", err))
+	}
+
+	trackers, err := s.repo.GetAllTrackers(criteria)
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list spell slot trackers: %w", err))
+	}
+	return trackers, nil
+}
+
+func (s *spellSlotServiceImpl) GetTracker(id uuid.UUID) (*models.SpellSlotTracker, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker ID: %s", id.String()))
+	}
+
+	tracker, err := s.repo.GetTrackerByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get spell slot tracker by ID: %w", err))
+	}
+	return tracker, nil
+}
+
+func (s *spellSlotServiceImpl) CreateTracker(request *models.SpellSlotTrackerRequest) (*models.SpellSlotTracker, error) {
+	if request.OwnerID == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, errors.New("owner ID cannot be empty"))
+	}
+
+	tracker := &models.SpellSlotTracker{OwnerID: request.OwnerID}
+	if err := setMaximums(tracker, request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker data: %w", err))
+	}
+
+	existingTracker, _ := s.repo.GetTrackerByOwnerID(request.OwnerID)
+	if existingTracker != nil {
+		return nil, failure.NewError(failure.ErrorConflict, fmt.Errorf("owner %s already has spell slot tracker %s", request.OwnerID.String(), existingTracker.ID.String()))
+	}
+
+	if err := s.repo.CreateTracker(tracker); err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to create spell slot tracker: %w", err))
+	}
+	return tracker, nil
+}
+
+func (s *spellSlotServiceImpl) UpdateTrackerSlots(id uuid.UUID, request *models.SpellSlotTrackerRequest) (*models.SpellSlotTracker, error) {
+	return s.update(id, "update spell slots", func(tracker *models.SpellSlotTracker) error {
+		if request.OwnerID != tracker.OwnerID {
+			return failure.NewError(failure.ErrorBadRequest, errors.New("the owner of a spell slot tracker cannot change"))
+		}
+		if err := setMaximums(tracker, request); err != nil {
+			return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker data: %w", err))
+		}
+		return nil
+	})
+}
+
+func (s *spellSlotServiceImpl) RemoveTracker(id uuid.UUID) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteTracker(id); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove spell slot tracker: %w", err))
+	}
+	return nil
+}
+
+func (s *spellSlotServiceImpl) ConsumeSlot(id uuid.UUID, request *models.ConsumeSlotRequest) (*models.SlotConsumption, error) {
+	if err := validateConsumeRequest(request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot consumption: %w", err))
+	}
+
+	consumption := &models.SlotConsumption{SpellLevel: request.SpellLevel, Pact: request.Pact}
+	tracker, err := s.update(id, "consume spell slot", func(tracker *models.SpellSlotTracker) error {
+		slotLevel, err := tracker.Consume(request)
+		if errors.Is(err, models.ErrNoSlotAvailable) {
+			return failure.NewError(failure.ErrorConflict, err)
+		}
+		if err != nil {
+			return failure.NewError(failure.ErrorBadRequest, err)
+		}
+		consumption.SlotLevel = slotLevel
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	consumption.Tracker = tracker
+	return consumption, nil
+}
+
+func (s *spellSlotServiceImpl) RestoreSlots(id uuid.UUID, request *models.RestoreSlotRequest) (*models.SpellSlotTracker, error) {
+	if err := validateRestoreRequest(request); err != nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot restoration: %w", err))
+	}
+
+	return s.update(id, "restore spell slots", func(tracker *models.SpellSlotTracker) error {
+		tracker.Restore(request)
+		return nil
+	})
+}
+
+func (s *spellSlotServiceImpl) ShortRest(id uuid.UUID) (*models.SpellSlotTracker, error) {
+	return s.update(id, "take a short rest", func(tracker *models.SpellSlotTracker) error {
+		tracker.ShortRest()
+		return nil
+	})
+}
+
+func (s *spellSlotServiceImpl) LongRest(id uuid.UUID) (*models.SpellSlotTracker, error) {
+	return s.update(id, "take a long rest", func(tracker *models.SpellSlotTracker) error {
+		tracker.LongRest()
+		return nil
+	})
+}
+
+// update applies change to the tracker while it is locked against concurrent updates.
+func (s *spellSlotServiceImpl) update(id uuid.UUID, action string, change func(tracker *models.SpellSlotTracker) error) (*models.SpellSlotTracker, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid spell slot tracker ID: %s", id.String()))
+	}
+
+	tracker, err := s.repo.UpdateTracker(id, change)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to %s: %w", action, err))
+	}
+	return tracker, nil
+}
+
+// setMaximums validates the requested maximum slots, filling them from the caster tables when levels are given, and
+// applies them to the tracker.
+func setMaximums(tracker *models.SpellSlotTracker, request *models.SpellSlotTrackerRequest) error {
+	slots := request.Slots
+	if len(slots) > 0 && request.CasterLevel != 0 {
+		return errors.New("give either slots or caster_level, not both")
+	}
+	if request.CasterLevel < 0 || request.CasterLevel > classModels.MaxLevel {
+		return fmt.Errorf("caster level must be between 0 and %d: %d", classModels.MaxLevel, request.CasterLevel)
+	}
+	if len(slots) == 0 {
+		slots = classModels.SpellSlots(request.CasterLevel)
+	}
+	if len(slots) > models.MaxSpellLevel {
+		return fmt.Errorf("slots can be given for at most %d spell levels: %d", models.MaxSpellLevel, len(slots))
+	}
+	for i, count := range slots {
+		if count < 0 {
+			return fmt.Errorf("level %d slots cannot be negative: %d", i+1, count)
+		}
+	}
+
+	pactSlots, pactSlotLevel := request.PactSlots, request.PactSlotLevel
+	if pactSlots != 0 && request.PactLevel != 0 {
+		return errors.New("give either pact_slots or pact_level, not both")
+	}
+	if request.PactLevel < 0 || request.PactLevel > classModels.MaxLevel {
+		return fmt.Errorf("pact level must be between 0 and %d: %d", classModels.MaxLevel, request.PactLevel)
+	}
+	if request.PactLevel != 0 {
+		pactSlots, pactSlotLevel = classModels.PactMagicSlots(request.PactLevel)
+	}
+	if pactSlots < 0 {
+		return fmt.Errorf("pact slots cannot be negative: %d", pactSlots)
+	}
+	if pactSlots > 0 && (pactSlotLevel < 1 || pactSlotLevel > models.MaxSpellLevel) {
+		return fmt.Errorf("pact slot level must be between 1 and %d: %d", models.MaxSpellLevel, pactSlotLevel)
+	}
+	if pactSlots == 0 {
+		pactSlotLevel = 0
+	}
+
+	tracker.SetMaximums(slots, pactSlots, pactSlotLevel)
+	return nil
+}
+
+func validateConsumeRequest(request *models.ConsumeSlotRequest) error {
+	if request.SpellLevel < 1 || request.SpellLevel > models.MaxSpellLevel {
+		return fmt.Errorf("spell level must be between 1 and %d: %d", models.MaxSpellLevel, request.SpellLevel)
+	}
+	if request.SlotLevel == 0 {
+		return nil
+	}
+	if request.Pact {
+		return errors.New("pact magic slots are always spent at their own level")
+	}
+	if request.SlotLevel < request.SpellLevel || request.SlotLevel > models.MaxSpellLevel {
+		return fmt.Errorf("slot level must be between the spell level %d and %d: %d", request.SpellLevel, models.MaxSpellLevel, request.SlotLevel)
+	}
+	return nil
+}
+
+func validateRestoreRequest(request *models.RestoreSlotRequest) error {
+	if request.Count == 0 {
+		request.Count = 1
+	}
+	if request.Count < 0 {
+		return fmt.Errorf("count must be positive: %d", request.Count)
+	}
+	if !request.Pact && (request.Level < 1 || request.Level > models.MaxSpellLevel) {
+		return fmt.Errorf("level must be between 1 and %d: %d", models.MaxSpellLevel, request.Level)
+	}
+	return nil
+}
+
+func validateCriteria(criteria map[string]string) error {
+	for key, value := range criteria {
+		switch key {
+		case "owner_id":
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("owner_id must be a UUID: %s", value)
+			}
+		default:
+			return fmt.Errorf("unknown filter '%s', the allowed filter is owner_id", key)
+		}
+	}
+	return nil
+}
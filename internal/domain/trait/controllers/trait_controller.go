@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type TraitController interface {
+	GetAllTraits(ctx *gin.Context)
+	GetTraitByID(ctx *gin.Context)
+	CreateTrait(ctx *gin.Context)
+	UpdateTrait(ctx *gin.Context)
+	DeleteTrait(ctx *gin.Context)
+	GetTraitRaces(ctx *gin.Context)
+}
@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/trait/services"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/Casagrande-Lucas/dnd/pkg/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// traitControllerGin is a concrete implementation of TraitController using the Gin framework.
+type traitControllerGin struct {
+	service services.TraitService
+}
+
+// NewTraitControllerGin creates a new instance of traitControllerGin.
+func NewTraitControllerGin(service services.TraitService) TraitController {
+	return &traitControllerGin{
+		service: service,
+	}
+}
+
+// GetAllTraits godoc
+// @Summary      List all traits
+// @Description  Return all registered traits
+// @Tags         Traits
+// @Accept       json
+// @Produce      json
+// @Success      200 {array}  models.Trait
+// @Failure      500 {object} httperror.ErrorResponse
+// @Router       /traits [get]
+func (c *traitControllerGin) GetAllTraits(ctx *gin.Context) {
+	traits, err := c.service.ListTraits()
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, traits)
+}
+
+// GetTraitByID godoc
+// @Summary      Get trait by ID
+// @Description  Retrieve a trait using the provided ID
+// @Tags         Traits
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Trait ID (UUID)"
+// @Success      200  {object}  models.Trait
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /traits/{id} [get]
+func (c *traitControllerGin) GetTraitByID(ctx *gin.Context) {
+	id, err := parseTraitID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	trait, err := c.service.GetTraitDetails(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, trait)
+}
+
+// CreateTrait godoc
+// @Summary      Create trait
+// @Description  Create a new trait
+// @Tags         Traits
+// @Accept       json
+// @Produce      json
+// @Param        trait  body      models.Trait  true  "Trait info"
+// @Success      201    {object}  models.Trait
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      409    {object}  httperror.ErrorResponse
+// @Failure      500    {object}  httperror.ErrorResponse
+// @Router       /traits [post]
+func (c *traitControllerGin) CreateTrait(ctx *gin.Context) {
+	var trait models.Trait
+	if err := ctx.ShouldBindJSON(&trait); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RegisterTrait(&trait); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusCreated, trait)
+}
+
+// UpdateTrait godoc
+// @Summary      Update trait
+// @Description  Update an existing trait
+// @Tags         Traits
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string        true  "Trait ID (UUID)"
+// @Param        trait  body      models.Trait  true  "Trait info"
+// @Success      200    {object}  models.Trait
+// @Failure      400    {object}  httperror.ErrorResponse
+// @Failure      404    {object}  httperror.ErrorResponse
+// @Failure      409    {object}  httperror.ErrorResponse
+// @Router       /traits/{id} [put]
+func (c *traitControllerGin) UpdateTrait(ctx *gin.Context) {
+	id, err := parseTraitID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	var trait models.Trait
+	if err := ctx.ShouldBindJSON(&trait); err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, err))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.UpdateTraitInfo(id, &trait); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, trait)
+}
+
+// DeleteTrait godoc
+// @Summary      Delete trait
+// @Description  Delete an existing trait. Traits still assigned to a race or subrace are only deleted when force is true.
+// @Tags         Traits
+// @Param        id     path   string  true   "Trait ID (UUID)"
+// @Param        force  query  bool    false  "Also remove the trait from every race and subrace using it"
+// @Success      204
+// @Failure      400 {object} httperror.ErrorResponse
+// @Failure      404 {object} httperror.ErrorResponse
+// @Failure      409 {object} httperror.ErrorResponse
+// @Router       /traits/{id} [delete]
+func (c *traitControllerGin) DeleteTrait(ctx *gin.Context) {
+	id, err := parseTraitID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	force, err := strconv.ParseBool(ctx.DefaultQuery("force", "false"))
+	if err != nil {
+		apiError := httperror.FormError(failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid force flag: %w", err)))
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	if err := c.service.RemoveTrait(id, force); err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetTraitRaces godoc
+// @Summary      List races with trait
+// @Description  Return every race the trait is assigned to
+// @Tags         Traits
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Trait ID (UUID)"
+// @Success      200  {array}   models.Race
+// @Failure      400  {object}  httperror.ErrorResponse
+// @Failure      404  {object}  httperror.ErrorResponse
+// @Router       /traits/{id}/races [get]
+func (c *traitControllerGin) GetTraitRaces(ctx *gin.Context) {
+	id, err := parseTraitID(ctx)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+
+	races, err := c.service.ListRacesWithTrait(id)
+	if err != nil {
+		apiError := httperror.FormError(err)
+		ctx.JSON(apiError.StatusCode, apiError.ObjectErr)
+		return
+	}
+	ctx.JSON(http.StatusOK, races)
+}
+
+// parseTraitID reads the trait ID path parameter.
+func parseTraitID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait ID: %w", err))
+	}
+	return id, nil
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type TraitRepository interface {
+	GetAllTraits() ([]*models.Trait, error)
+	GetTraitByID(id uuid.UUID) (*models.Trait, error)
+	GetTraitByName(name string) (*models.Trait, error)
+	CreateTrait(trait *models.Trait) error
+	UpdateTrait(id uuid.UUID, trait *models.Trait) error
+	DeleteTrait(id uuid.UUID, force bool) error
+	GetRacesByTrait(id uuid.UUID) ([]*models.Race, error)
+}
@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// traitRepositoryGormImpl is a concrete implementation of the TraitRepository interface using GORM.
+type traitRepositoryGormImpl struct {
+	db *gorm.DB
+}
+
+// NewGormTraitRepository creates a new instance of traitRepositoryGormImpl.
+func NewGormTraitRepository(db *gorm.DB) TraitRepository {
+	return &traitRepositoryGormImpl{
+		db: db,
+	}
+}
+
+// GetAllTraits retrieves all traits from the database ordered by name.
+func (r *traitRepositoryGormImpl) GetAllTraits() ([]*models.Trait, error) {
+	var traits []*models.Trait
+	if err := r.db.Order("name").Find(&traits).Error; err != nil {
+		return nil, err
+	}
+	return traits, nil
+}
+
+// GetTraitByID retrieves a trait by its ID.
+func (r *traitRepositoryGormImpl) GetTraitByID(id uuid.UUID) (*models.Trait, error) {
+	var trait models.Trait
+	if err := r.db.First(&trait, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("trait with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &trait, nil
+}
+
+// GetTraitByName retrieves a trait by its name.
+func (r *traitRepositoryGormImpl) GetTraitByName(name string) (*models.Trait, error) {
+	var trait models.Trait
+	if err := r.db.Where("name = ?", name).First(&trait).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("trait with name '%s' %w", name, failure.ErrorNotFound)
+		}
+		return nil, err
+	}
+	return &trait, nil
+}
+
+// CreateTrait adds a new trait to the database.
+func (r *traitRepositoryGormImpl) CreateTrait(trait *models.Trait) error {
+	return r.db.Create(trait).Error
+}
+
+// UpdateTrait updates an existing trait's details in the database.
+func (r *traitRepositoryGormImpl) UpdateTrait(id uuid.UUID, trait *models.Trait) error {
+	var existingTrait models.Trait
+	if err := r.db.First(&existingTrait, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("trait with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	existingTrait.Name = trait.Name
+	existingTrait.Description = trait.Description
+	existingTrait.LimitedUse = trait.LimitedUse
+
+	if err := r.db.Save(&existingTrait).Error; err != nil {
+		return err
+	}
+
+	*trait = existingTrait
+	return nil
+}
+
+// DeleteTrait removes a trait from the database. A trait still referenced by a race or subrace
+// is only removed when force is set, in which case its race and subrace associations are dropped first.
+func (r *traitRepositoryGormImpl) DeleteTrait(id uuid.UUID, force bool) error {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var trait models.Trait
+	if err := tx.First(&trait, "id = ?", id).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("trait with ID %s %w", id.String(), failure.ErrorNotFound)
+		}
+		return err
+	}
+
+	var raceUsages, subraceUsages int64
+	if err := tx.Table("race_traits").Where("trait_id = ?", id).Count(&raceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Table("subrace_traits").Where("trait_id = ?", id).Count(&subraceUsages).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if raceUsages+subraceUsages > 0 && !force {
+		tx.Rollback()
+		return fmt.Errorf("trait with ID %s is still assigned to %d race(s) and %d subrace(s): %w", id.String(), raceUsages, subraceUsages, failure.ErrorConflict)
+	}
+
+	if err := tx.Exec("DELETE FROM race_traits WHERE trait_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Exec("DELETE FROM subrace_traits WHERE trait_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(&trait).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetRacesByTrait retrieves every race the trait is assigned to.
+func (r *traitRepositoryGormImpl) GetRacesByTrait(id uuid.UUID) ([]*models.Race, error) {
+	if _, err := r.GetTraitByID(id); err != nil {
+		return nil, err
+	}
+
+	var races []*models.Race
+	if err := r.db.Joins("JOIN race_traits ON race_traits.race_id = races.id").
+		Where("race_traits.trait_id = ?", id).
+		Order("races.name").
+		Find(&races).Error; err != nil {
+		return nil, err
+	}
+	return races, nil
+}
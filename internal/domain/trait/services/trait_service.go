@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/google/uuid"
+)
+
+type TraitService interface {
+	ListTraits() ([]*models.Trait, error)
+	GetTraitDetails(id uuid.UUID) (*models.Trait, error)
+	RegisterTrait(trait *models.Trait) error
+	UpdateTraitInfo(id uuid.UUID, trait *models.Trait) error
+	RemoveTrait(id uuid.UUID, force bool) error
+	ListRacesWithTrait(id uuid.UUID) ([]*models.Race, error)
+}
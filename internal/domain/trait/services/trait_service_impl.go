@@ -0,0 +1,114 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Casagrande-Lucas/dnd/internal/domain/race/models"
+	"github.com/Casagrande-Lucas/dnd/internal/domain/trait/repositories"
+	"github.com/Casagrande-Lucas/dnd/pkg/failure"
+	"github.com/google/uuid"
+)
+
+// traitServiceImpl is the concrete implementation of TraitService.
+type traitServiceImpl struct {
+	repo repositories.TraitRepository
+}
+
+// NewTraitService creates a new instance of traitServiceImpl.
+func NewTraitService(repo repositories.TraitRepository) TraitService {
+	return &traitServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *traitServiceImpl) ListTraits() ([]*models.Trait, error) {
+	traits, err := s.repo.GetAllTraits()
+	if err != nil {
+		return nil, failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to get list traits: %w", err))
+	}
+	return traits, nil
+}
+
+func (s *traitServiceImpl) GetTraitDetails(id uuid.UUID) (*models.Trait, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait ID: %s", id.String()))
+	}
+
+	trait, err := s.repo.GetTraitByID(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to get trait details by ID: %w", err))
+	}
+	return trait, nil
+}
+
+func (s *traitServiceImpl) RegisterTrait(trait *models.Trait) error {
+	if err := validateTrait(trait); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait data: %w", err))
+	}
+
+	existingTrait, _ := s.repo.GetTraitByName(trait.Name)
+	if existingTrait != nil {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("trait with name '%s' already exists", trait.Name))
+	}
+
+	if err := s.repo.CreateTrait(trait); err != nil {
+		return failure.NewError(failure.ErrorInternalServer, fmt.Errorf("failed to register trait: %w", err))
+	}
+	return nil
+}
+
+func (s *traitServiceImpl) UpdateTraitInfo(id uuid.UUID, trait *models.Trait) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait ID: %s", id.String()))
+	}
+
+	if err := validateTrait(trait); err != nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait data: %w", err))
+	}
+
+	duplicateTrait, _ := s.repo.GetTraitByName(trait.Name)
+	if duplicateTrait != nil && duplicateTrait.ID != id {
+		return failure.NewError(failure.ErrorConflict, fmt.Errorf("trait with name '%s' already exists", trait.Name))
+	}
+
+	if err := s.repo.UpdateTrait(id, trait); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to update trait info: %w", err))
+	}
+	return nil
+}
+
+func (s *traitServiceImpl) RemoveTrait(id uuid.UUID, force bool) error {
+	if id == uuid.Nil {
+		return failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait ID: %s", id.String()))
+	}
+
+	if err := s.repo.DeleteTrait(id, force); err != nil {
+		return failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to remove trait: %w", err))
+	}
+	return nil
+}
+
+func (s *traitServiceImpl) ListRacesWithTrait(id uuid.UUID) ([]*models.Race, error) {
+	if id == uuid.Nil {
+		return nil, failure.NewError(failure.ErrorBadRequest, fmt.Errorf("invalid trait ID: %s", id.String()))
+	}
+
+	races, err := s.repo.GetRacesByTrait(id)
+	if err != nil {
+		return nil, failure.NewError(failure.Kind(err, failure.ErrorInternalServer), fmt.Errorf("failed to list races with trait: %w", err))
+	}
+	return races, nil
+}
+
+func validateTrait(trait *models.Trait) error {
+	if trait.Name == "" {
+		return errors.New("trait name cannot be empty")
+	}
+	if trait.LimitedUse != nil {
+		if err := trait.LimitedUse.Validate(); err != nil {
+			return fmt.Errorf("trait '%s': %w", trait.Name, err)
+		}
+	}
+	return nil
+}
@@ -2,9 +2,51 @@ package api
 
 import (
 	"github.com/Casagrande-Lucas/dnd/config"
+	abilityScoreControllers "github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/controllers"
+	abilityScoreServices "github.com/Casagrande-Lucas/dnd/internal/domain/abilityscore/services"
+	backgroundControllers "github.com/Casagrande-Lucas/dnd/internal/domain/background/controllers"
+	backgroundRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/background/repositories"
+	backgroundServices "github.com/Casagrande-Lucas/dnd/internal/domain/background/services"
+	characterControllers "github.com/Casagrande-Lucas/dnd/internal/domain/character/controllers"
+	characterRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/character/repositories"
+	characterServices "github.com/Casagrande-Lucas/dnd/internal/domain/character/services"
+	classControllers "github.com/Casagrande-Lucas/dnd/internal/domain/class/controllers"
+	classRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/class/repositories"
+	classServices "github.com/Casagrande-Lucas/dnd/internal/domain/class/services"
+	conditionControllers "github.com/Casagrande-Lucas/dnd/internal/domain/condition/controllers"
+	conditionRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/condition/repositories"
+	conditionServices "github.com/Casagrande-Lucas/dnd/internal/domain/condition/services"
+	diceControllers "github.com/Casagrande-Lucas/dnd/internal/domain/dice/controllers"
+	diceServices "github.com/Casagrande-Lucas/dnd/internal/domain/dice/services"
+	equipmentControllers "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/controllers"
+	equipmentRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/repositories"
+	equipmentServices "github.com/Casagrande-Lucas/dnd/internal/domain/equipment/services"
+	languageControllers "github.com/Casagrande-Lucas/dnd/internal/domain/language/controllers"
+	languageRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/language/repositories"
+	languageServices "github.com/Casagrande-Lucas/dnd/internal/domain/language/services"
+	proficiencyControllers "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/controllers"
+	proficiencyRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/repositories"
+	proficiencyServices "github.com/Casagrande-Lucas/dnd/internal/domain/proficiency/services"
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/controllers"
 	persistenceGorm "github.com/Casagrande-Lucas/dnd/internal/domain/race/repositories"
 	"github.com/Casagrande-Lucas/dnd/internal/domain/race/services"
+	resourceControllers "github.com/Casagrande-Lucas/dnd/internal/domain/resource/controllers"
+	resourceRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/resource/repositories"
+	resourceServices "github.com/Casagrande-Lucas/dnd/internal/domain/resource/services"
+	rulesControllers "github.com/Casagrande-Lucas/dnd/internal/domain/rules/controllers"
+	rulesServices "github.com/Casagrande-Lucas/dnd/internal/domain/rules/services"
+	searchControllers "github.com/Casagrande-Lucas/dnd/internal/domain/search/controllers"
+	searchRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/search/repositories"
+	searchServices "github.com/Casagrande-Lucas/dnd/internal/domain/search/services"
+	spellControllers "github.com/Casagrande-Lucas/dnd/internal/domain/spell/controllers"
+	spellRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/spell/repositories"
+	spellServices "github.com/Casagrande-Lucas/dnd/internal/domain/spell/services"
+	spellSlotControllers "github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/controllers"
+	spellSlotRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/repositories"
+	spellSlotServices "github.com/Casagrande-Lucas/dnd/internal/domain/spellslot/services"
+	traitControllers "github.com/Casagrande-Lucas/dnd/internal/domain/trait/controllers"
+	traitRepositories "github.com/Casagrande-Lucas/dnd/internal/domain/trait/repositories"
+	traitServices "github.com/Casagrande-Lucas/dnd/internal/domain/trait/services"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -53,6 +95,79 @@ func (g *ginServer) RegisterServerRoutes() {
 	raceService := services.NewRaceService(raceRepo)
 	raceController := controllers.NewRaceControllerGin(raceService)
 
+	subraceRepo := persistenceGorm.NewGormSubraceRepository(g.dbConn)
+	subraceService := services.NewSubraceService(subraceRepo)
+	subraceController := controllers.NewSubraceControllerGin(subraceService)
+
+	traitRepo := traitRepositories.NewGormTraitRepository(g.dbConn)
+	traitService := traitServices.NewTraitService(traitRepo)
+	traitController := traitControllers.NewTraitControllerGin(traitService)
+
+	languageRepo := languageRepositories.NewGormLanguageRepository(g.dbConn)
+	languageService := languageServices.NewLanguageService(languageRepo)
+	languageController := languageControllers.NewLanguageControllerGin(languageService)
+
+	proficiencyRepo := proficiencyRepositories.NewGormProficiencyRepository(g.dbConn)
+	proficiencyService := proficiencyServices.NewProficiencyService(proficiencyRepo)
+	proficiencyController := proficiencyControllers.NewProficiencyControllerGin(proficiencyService)
+
+	characterRepo := characterRepositories.NewGormCharacterRepository(g.dbConn)
+	characterService := characterServices.NewCharacterService(characterRepo, raceService)
+	characterController := characterControllers.NewCharacterControllerGin(characterService)
+
+	classRepo := classRepositories.NewGormClassRepository(g.dbConn)
+	classService := classServices.NewClassService(classRepo, proficiencyService)
+	classController := classControllers.NewClassControllerGin(classService)
+
+	backgroundRepo := backgroundRepositories.NewGormBackgroundRepository(g.dbConn)
+	backgroundService := backgroundServices.NewBackgroundService(backgroundRepo, proficiencyService)
+	backgroundController := backgroundControllers.NewBackgroundControllerGin(backgroundService)
+
+	spellRepo := spellRepositories.NewGormSpellRepository(g.dbConn)
+	spellService := spellServices.NewSpellService(spellRepo, classService)
+	spellController := spellControllers.NewSpellControllerGin(spellService)
+
+	spellSlotRepo := spellSlotRepositories.NewGormSpellSlotRepository(g.dbConn)
+	spellSlotService := spellSlotServices.NewSpellSlotService(spellSlotRepo)
+	spellSlotController := spellSlotControllers.NewSpellSlotControllerGin(spellSlotService)
+
+	resourceRepo := resourceRepositories.NewGormResourceRepository(g.dbConn)
+	resourceService := resourceServices.NewResourceService(resourceRepo, raceService)
+	resourceController := resourceControllers.NewResourceControllerGin(resourceService)
+
+	conditionRepo := conditionRepositories.NewGormConditionRepository(g.dbConn)
+	conditionService := conditionServices.NewConditionService(conditionRepo)
+	conditionController := conditionControllers.NewConditionControllerGin(conditionService)
+
+	activeEffectRepo := conditionRepositories.NewGormActiveEffectRepository(g.dbConn)
+	activeEffectService := conditionServices.NewActiveEffectService(activeEffectRepo, conditionService, raceService)
+	activeEffectController := conditionControllers.NewActiveEffectControllerGin(activeEffectService)
+
+	searchRepo := searchRepositories.NewGormSearchRepository(g.dbConn)
+	searchService := searchServices.NewSearchService(searchRepo)
+	searchController := searchControllers.NewSearchControllerGin(searchService)
+
+	equipmentRepo := equipmentRepositories.NewGormEquipmentRepository(g.dbConn)
+	equipmentService := equipmentServices.NewEquipmentService(equipmentRepo)
+	equipmentController := equipmentControllers.NewEquipmentControllerGin(equipmentService)
+
+	diceService := diceServices.NewDiceService()
+	diceController := diceControllers.NewDiceControllerGin(diceService)
+
+	abilityScoreService := abilityScoreServices.NewAbilityScoreService(raceService)
+	abilityScoreController := abilityScoreControllers.NewAbilityScoreControllerGin(abilityScoreService)
+
+	rulesService := rulesServices.NewRulesService(raceService, proficiencyService, equipmentService, classService)
+	rulesController := rulesControllers.NewRulesControllerGin(rulesService)
+
+	levelUpRepo := characterRepositories.NewGormLevelUpRepository(g.dbConn)
+	levelUpService := characterServices.NewLevelUpService(levelUpRepo, characterService, raceService, classService)
+	levelUpController := characterControllers.NewLevelUpControllerGin(levelUpService)
+
+	draftRepo := characterRepositories.NewGormDraftRepository(g.dbConn)
+	draftService := characterServices.NewDraftService(draftRepo, raceService, classService, backgroundService, proficiencyService)
+	draftController := characterControllers.NewDraftControllerGin(draftService)
+
 	g.app.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"message": "OK"})
 	})
@@ -65,13 +180,194 @@ func (g *ginServer) RegisterServerRoutes() {
 			raceV1Group.GET("/:id", raceController.GetRaceByID)
 			raceV1Group.POST("/", raceController.CreateRace)
 			raceV1Group.PUT("/:id", raceController.UpdateRace)
+			raceV1Group.PATCH("/:id", raceController.PatchRace)
 			raceV1Group.DELETE("/:id", raceController.DeleteRace)
+			raceV1Group.GET("/:id/subraces", subraceController.GetSubraces)
 			raceV1Group.POST("/:id/subraces", raceController.AddSubrace)
+			raceV1Group.GET("/:id/subraces/:subraceID", subraceController.GetSubraceByID)
+			raceV1Group.PUT("/:id/subraces/:subraceID", subraceController.UpdateSubrace)
+			raceV1Group.PATCH("/:id/subraces/:subraceID", subraceController.PatchSubrace)
 			raceV1Group.DELETE("/:id/subraces/:subraceID", raceController.RemoveSubrace)
+			raceV1Group.GET("/:id/subraces/:subraceID/effective", raceController.GetEffectiveRace)
+			raceV1Group.POST("/:id/ability-bonuses", raceController.ResolveAbilityBonuses)
 			raceV1Group.POST("/:id/traits/:traitID", raceController.AddTrait)
 			raceV1Group.DELETE("/:id/traits/:traitID", raceController.RemoveTrait)
 			raceV1Group.GET("/search", raceController.SearchRaces)
 		}
+
+		traitV1Group := v1Group.Group("/traits")
+		{
+			traitV1Group.GET("/", traitController.GetAllTraits)
+			traitV1Group.GET("/:id", traitController.GetTraitByID)
+			traitV1Group.POST("/", traitController.CreateTrait)
+			traitV1Group.PUT("/:id", traitController.UpdateTrait)
+			traitV1Group.DELETE("/:id", traitController.DeleteTrait)
+			traitV1Group.GET("/:id/races", traitController.GetTraitRaces)
+		}
+
+		languageV1Group := v1Group.Group("/languages")
+		{
+			languageV1Group.GET("/", languageController.GetAllLanguages)
+			languageV1Group.GET("/:id", languageController.GetLanguageByID)
+			languageV1Group.POST("/", languageController.CreateLanguage)
+			languageV1Group.PUT("/:id", languageController.UpdateLanguage)
+			languageV1Group.DELETE("/:id", languageController.DeleteLanguage)
+			languageV1Group.GET("/:id/speakers", languageController.GetLanguageSpeakers)
+		}
+
+		proficiencyV1Group := v1Group.Group("/proficiencies")
+		{
+			proficiencyV1Group.GET("/", proficiencyController.GetAllProficiencies)
+			proficiencyV1Group.GET("/:id", proficiencyController.GetProficiencyByID)
+			proficiencyV1Group.POST("/", proficiencyController.CreateProficiency)
+			proficiencyV1Group.PUT("/:id", proficiencyController.UpdateProficiency)
+			proficiencyV1Group.DELETE("/:id", proficiencyController.DeleteProficiency)
+		}
+
+		characterV1Group := v1Group.Group("/characters")
+		{
+			characterV1Group.GET("/", characterController.GetAllCharacters)
+			characterV1Group.GET("/:id", characterController.GetCharacterByID)
+			characterV1Group.POST("/", characterController.CreateCharacter)
+			characterV1Group.PUT("/:id", characterController.UpdateCharacter)
+			characterV1Group.DELETE("/:id", characterController.DeleteCharacter)
+			characterV1Group.GET("/:id/level-up", levelUpController.PreviewLevelUp)
+			characterV1Group.POST("/:id/level-up", levelUpController.LevelUp)
+			characterV1Group.DELETE("/:id/level-up", levelUpController.RevertLevelUp)
+			characterV1Group.GET("/:id/level-history", levelUpController.GetLevelHistory)
+			characterV1Group.POST("/:id/experience", levelUpController.AwardExperience)
+		}
+
+		classV1Group := v1Group.Group("/classes")
+		{
+			classV1Group.GET("/", classController.GetAllClasses)
+			classV1Group.GET("/:id", classController.GetClassByID)
+			classV1Group.POST("/", classController.CreateClass)
+			classV1Group.PUT("/:id", classController.UpdateClass)
+			classV1Group.DELETE("/:id", classController.DeleteClass)
+			classV1Group.GET("/:id/levels", classController.GetLevelTable)
+			classV1Group.GET("/:id/levels/:level", classController.GetClassLevel)
+			classV1Group.GET("/:id/spells", spellController.GetClassSpells)
+		}
+
+		backgroundV1Group := v1Group.Group("/backgrounds")
+		{
+			backgroundV1Group.GET("/", backgroundController.GetAllBackgrounds)
+			backgroundV1Group.GET("/:id", backgroundController.GetBackgroundByID)
+			backgroundV1Group.POST("/", backgroundController.CreateBackground)
+			backgroundV1Group.PUT("/:id", backgroundController.UpdateBackground)
+			backgroundV1Group.DELETE("/:id", backgroundController.DeleteBackground)
+			backgroundV1Group.POST("/:id/personality", backgroundController.RollPersonality)
+		}
+
+		spellV1Group := v1Group.Group("/spells")
+		{
+			spellV1Group.GET("/", spellController.GetAllSpells)
+			spellV1Group.GET("/:id", spellController.GetSpellByID)
+			spellV1Group.POST("/", spellController.CreateSpell)
+			spellV1Group.PUT("/:id", spellController.UpdateSpell)
+			spellV1Group.DELETE("/:id", spellController.DeleteSpell)
+		}
+
+		spellSlotV1Group := v1Group.Group("/spell-slots")
+		{
+			spellSlotV1Group.GET("/", spellSlotController.GetAllTrackers)
+			spellSlotV1Group.GET("/:id", spellSlotController.GetTrackerByID)
+			spellSlotV1Group.POST("/", spellSlotController.CreateTracker)
+			spellSlotV1Group.PUT("/:id", spellSlotController.UpdateTracker)
+			spellSlotV1Group.DELETE("/:id", spellSlotController.DeleteTracker)
+			spellSlotV1Group.POST("/:id/consume", spellSlotController.ConsumeSlot)
+			spellSlotV1Group.POST("/:id/restore", spellSlotController.RestoreSlots)
+			spellSlotV1Group.POST("/:id/rest/short", spellSlotController.ShortRest)
+			spellSlotV1Group.POST("/:id/rest/long", spellSlotController.LongRest)
+		}
+
+		resourceV1Group := v1Group.Group("/trackers")
+		{
+			resourceV1Group.GET("/", resourceController.GetAllTrackers)
+			resourceV1Group.GET("/:id", resourceController.GetTrackerByID)
+			resourceV1Group.POST("/", resourceController.CreateTracker)
+			resourceV1Group.PUT("/:id", resourceController.UpdateTracker)
+			resourceV1Group.DELETE("/:id", resourceController.DeleteTracker)
+			resourceV1Group.POST("/:id/use", resourceController.UseResource)
+			resourceV1Group.POST("/:id/rest/short", resourceController.ShortRest)
+			resourceV1Group.POST("/:id/rest/long", resourceController.LongRest)
+			resourceV1Group.POST("/:id/dawn", resourceController.Dawn)
+			resourceV1Group.POST("/:id/recharge", resourceController.RollRecharges)
+		}
+
+		conditionV1Group := v1Group.Group("/conditions")
+		{
+			conditionV1Group.GET("/", conditionController.GetAllConditions)
+			conditionV1Group.GET("/:id", conditionController.GetConditionByID)
+			conditionV1Group.POST("/", conditionController.CreateCondition)
+			conditionV1Group.POST("/standard", conditionController.InstallStandardConditions)
+			conditionV1Group.PUT("/:id", conditionController.UpdateCondition)
+			conditionV1Group.DELETE("/:id", conditionController.DeleteCondition)
+		}
+
+		activeEffectV1Group := v1Group.Group("/effects")
+		{
+			activeEffectV1Group.GET("/", activeEffectController.GetAllEffects)
+			activeEffectV1Group.GET("/effective", activeEffectController.GetEffectiveStats)
+			activeEffectV1Group.GET("/:id", activeEffectController.GetEffectByID)
+			activeEffectV1Group.POST("/", activeEffectController.ApplyEffect)
+			activeEffectV1Group.POST("/advance", activeEffectController.AdvanceTime)
+			activeEffectV1Group.DELETE("/concentration/:sourceID", activeEffectController.EndConcentration)
+			activeEffectV1Group.DELETE("/:id", activeEffectController.DeleteEffect)
+		}
+
+		searchV1Group := v1Group.Group("/search")
+		{
+			searchV1Group.GET("/", searchController.Search)
+			searchV1Group.GET("/suggest", searchController.Suggest)
+		}
+
+		equipmentV1Group := v1Group.Group("/equipment")
+		{
+			equipmentV1Group.GET("/", equipmentController.GetAllEquipment)
+			equipmentV1Group.GET("/:id", equipmentController.GetEquipmentByID)
+			equipmentV1Group.POST("/", equipmentController.CreateEquipment)
+			equipmentV1Group.PUT("/:id", equipmentController.UpdateEquipment)
+			equipmentV1Group.DELETE("/:id", equipmentController.DeleteEquipment)
+		}
+
+		diceV1Group := v1Group.Group("/dice")
+		{
+			diceV1Group.POST("/roll", diceController.Roll)
+			diceV1Group.POST("/stats", diceController.Stats)
+		}
+
+		abilityScoreV1Group := v1Group.Group("/ability-scores")
+		{
+			abilityScoreV1Group.POST("/point-buy", abilityScoreController.PointBuy)
+			abilityScoreV1Group.POST("/standard-array", abilityScoreController.StandardArray)
+			abilityScoreV1Group.POST("/roll", abilityScoreController.Roll)
+			abilityScoreV1Group.POST("/racial", abilityScoreController.ApplyRacialBonuses)
+		}
+
+		rulesV1Group := v1Group.Group("/rules")
+		{
+			rulesV1Group.POST("/derived-stats", rulesController.DerivedStats)
+			rulesV1Group.POST("/multiclass", rulesController.Multiclass)
+		}
+
+		draftV1Group := v1Group.Group("/character-drafts")
+		{
+			draftV1Group.POST("/", draftController.StartDraft)
+			draftV1Group.GET("/:id", draftController.GetDraftByID)
+			draftV1Group.DELETE("/:id", draftController.DiscardDraft)
+			draftV1Group.PUT("/:id/race", draftController.ChooseRace)
+			draftV1Group.PUT("/:id/class", draftController.ChooseClass)
+			draftV1Group.PUT("/:id/ability-scores", draftController.SetAbilityScores)
+			draftV1Group.PUT("/:id/background", draftController.ChooseBackground)
+			draftV1Group.PUT("/:id/equipment", draftController.ChooseEquipment)
+			draftV1Group.PUT("/:id/description", draftController.DescribeCharacter)
+			draftV1Group.PUT("/:id/skills", draftController.ChooseSkills)
+			draftV1Group.POST("/:id/hit-points", draftController.CalculateHitPoints)
+			draftV1Group.PUT("/:id/spells", draftController.ChooseSpells)
+			draftV1Group.POST("/:id/finalize", draftController.FinalizeDraft)
+		}
 	}
 
 	g.app.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
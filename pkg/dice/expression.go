@@ -0,0 +1,244 @@
+package dice
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	MaxDice       = 100
+	MaxSides      = 1000
+	MaxTerms      = 20
+	MaxExplosions = 10
+)
+
+const (
+	KeepAll     = ""
+	KeepHighest = "highest"
+	KeepLowest  = "lowest"
+)
+
+var ErrInvalidExpression = errors.New("invalid dice expression")
+
+// Term is one signed part of an expression: either a group of identical dice or a constant.
+type Term struct {
+	Sign      int    `json:"sign"`
+	Count     int    `json:"count,omitempty"`
+	Sides     int    `json:"sides,omitempty"`
+	Keep      string `json:"keep,omitempty" enums:"highest,lowest"`
+	KeepCount int    `json:"keep_count,omitempty"`
+	Explode   bool   `json:"explode,omitempty"`
+	Constant  int    `json:"constant,omitempty"`
+}
+
+// Expression is a parsed dice notation such as 2d6+3, 4d6kh3, 1d20adv or 3d6!.
+type Expression struct {
+	Notation string `json:"notation"`
+	Terms    []Term `json:"terms"`
+}
+
+// IsDice reports whether the term rolls dice rather than adding a constant.
+func (t Term) IsDice() bool {
+	return t.Sides > 0
+}
+
+// String formats the term in canonical notation, without its sign.
+func (t Term) String() string {
+	if !t.IsDice() {
+		return strconv.Itoa(t.Constant)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%dd%d", t.Count, t.Sides)
+	if t.Explode {
+		b.WriteString("!")
+	}
+	switch t.Keep {
+	case KeepHighest:
+		fmt.Fprintf(&b, "kh%d", t.KeepCount)
+	case KeepLowest:
+		fmt.Fprintf(&b, "kl%d", t.KeepCount)
+	}
+	return b.String()
+}
+
+// String formats the expression in canonical notation.
+func (e *Expression) String() string {
+	var b strings.Builder
+	for i, term := range e.Terms {
+		switch {
+		case term.Sign < 0:
+			b.WriteString("-")
+		case i > 0:
+			b.WriteString("+")
+		}
+		b.WriteString(term.String())
+	}
+	return b.String()
+}
+
+// Parse reads standard dice notation. A term is a constant or NdS, where N defaults to 1 and S may be % for 100,
+// optionally followed by ! to explode on the highest face, and by one of khN, klN, dhN, dlN (kN is khN), or by
+// adv or dis, which turn a single die into two keeping the highest or lowest. Terms are joined by + or -.
+func Parse(notation string) (*Expression, error) {
+	input := strings.ToLower(strings.Join(strings.Fields(notation), ""))
+	if input == "" {
+		return nil, fmt.Errorf("%w: empty expression", ErrInvalidExpression)
+	}
+
+	expr := &Expression{}
+	p := &parser{input: input}
+	for !p.done() {
+		sign := 1
+		switch {
+		case p.accept("+"):
+		case p.accept("-"):
+			sign = -1
+		case len(expr.Terms) > 0:
+			return nil, p.errorf("expected + or -")
+		}
+
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		term.Sign = sign
+		expr.Terms = append(expr.Terms, term)
+		if len(expr.Terms) > MaxTerms {
+			return nil, fmt.Errorf("%w: more than %d terms", ErrInvalidExpression, MaxTerms)
+		}
+	}
+
+	expr.Notation = expr.String()
+	return expr, nil
+}
+
+// MustParse is like Parse but panics on an invalid expression. It is meant for notation known at compile time.
+func MustParse(notation string) *Expression {
+	expr, err := Parse(notation)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) accept(token string) bool {
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *parser) acceptAny(tokens ...string) (string, bool) {
+	for _, token := range tokens {
+		if p.accept(token) {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) number() (int, bool) {
+	start := p.pos
+	for !p.done() && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, false
+	}
+	n, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.pos+1)
+}
+
+func (p *parser) term() (Term, error) {
+	count, hasCount := p.number()
+	if !p.accept("d") {
+		if !hasCount {
+			return Term{}, p.errorf("expected a number or a die")
+		}
+		return Term{Constant: count}, nil
+	}
+	if !hasCount {
+		count = 1
+	}
+
+	sides, ok := p.number()
+	if !ok {
+		if !p.accept("%") {
+			return Term{}, p.errorf("expected the number of sides")
+		}
+		sides = 100
+	}
+
+	if count < 1 || count > MaxDice {
+		return Term{}, p.errorf("dice count must be between 1 and %d", MaxDice)
+	}
+	if sides < 1 || sides > MaxSides {
+		return Term{}, p.errorf("dice sides must be between 1 and %d", MaxSides)
+	}
+
+	term := Term{Count: count, Sides: sides}
+	if p.accept("!") {
+		if sides == 1 {
+			return Term{}, p.errorf("a one-sided die cannot explode")
+		}
+		term.Explode = true
+	}
+
+	switch {
+	case p.accept("adv"):
+		if count != 1 {
+			return Term{}, p.errorf("advantage applies to a single die")
+		}
+		term.Count, term.Keep, term.KeepCount = 2, KeepHighest, 1
+	case p.accept("dis"):
+		if count != 1 {
+			return Term{}, p.errorf("disadvantage applies to a single die")
+		}
+		term.Count, term.Keep, term.KeepCount = 2, KeepLowest, 1
+	default:
+		op, ok := p.acceptAny("kh", "kl", "dh", "dl", "k")
+		if !ok {
+			break
+		}
+		n, ok := p.number()
+		if !ok {
+			return Term{}, p.errorf("expected the number of dice to keep or drop")
+		}
+		switch op {
+		case "kh", "k":
+			term.Keep, term.KeepCount = KeepHighest, n
+		case "kl":
+			term.Keep, term.KeepCount = KeepLowest, n
+		case "dh":
+			term.Keep, term.KeepCount = KeepLowest, count-n
+		case "dl":
+			term.Keep, term.KeepCount = KeepHighest, count-n
+		}
+		if term.KeepCount < 1 || term.KeepCount > count {
+			return Term{}, p.errorf("must keep between 1 and %d dice", count)
+		}
+		if term.KeepCount == count {
+			term.Keep, term.KeepCount = KeepAll, 0
+		}
+	}
+	return term, nil
+}
@@ -0,0 +1,105 @@
+package dice
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     string
+	}{
+		{"2d6+3", "2d6+3"},
+		{" 1D20 + 5 ", "1d20+5"},
+		{"d20", "1d20"},
+		{"d%", "1d100"},
+		{"-1d4", "-1d4"},
+		{"7", "7"},
+		{"4d6k3", "4d6kh3"},
+		{"4d6kl1", "4d6kl1"},
+		{"4d6dl1", "4d6kh3"},
+		{"4d6dh1", "4d6kl3"},
+		{"4d6kh4", "4d6"},
+		{"1d20adv", "2d20kh1"},
+		{"1d20dis-2", "2d20kl1-2"},
+		{"3d6!", "3d6!"},
+		{"2d10!kh1", "2d10!kh1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			expr, err := Parse(tt.notation)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.notation, err)
+			}
+			if expr.Notation != tt.want {
+				t.Errorf("Parse(%q).Notation = %q, want %q", tt.notation, expr.Notation, tt.want)
+			}
+			if expr.String() != tt.want {
+				t.Errorf("Parse(%q).String() = %q, want %q", tt.notation, expr.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTerms(t *testing.T) {
+	expr, err := Parse("4d6dl1-1d8!+2")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	want := []Term{
+		{Sign: 1, Count: 4, Sides: 6, Keep: KeepHighest, KeepCount: 3},
+		{Sign: -1, Count: 1, Sides: 8, Explode: true},
+		{Sign: 1, Constant: 2},
+	}
+	if len(expr.Terms) != len(want) {
+		t.Fatalf("got %d terms, want %d", len(expr.Terms), len(want))
+	}
+	for i, term := range expr.Terms {
+		if term != want[i] {
+			t.Errorf("term %d = %+v, want %+v", i, term, want[i])
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"   ",
+		"d",
+		"2d",
+		"0d6",
+		"101d6",
+		"1d0",
+		"1d1001",
+		"1d1!",
+		"2d20adv",
+		"2d20dis",
+		"4d6kh",
+		"4d6kh5",
+		"4d6kh0",
+		"4d6dh4",
+		"2d6x",
+		"1d6++2",
+		"2d6 3d6",
+		strings.Repeat("1d6+", MaxTerms) + "1",
+	}
+	for _, notation := range tests {
+		t.Run(notation, func(t *testing.T) {
+			if _, err := Parse(notation); !errors.Is(err, ErrInvalidExpression) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidExpression", notation, err)
+			}
+		})
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse did not panic on an invalid expression")
+		}
+	}()
+	MustParse("1d")
+}
@@ -0,0 +1,115 @@
+package dice
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// RNG is the source of randomness used to roll dice. *rand.Rand satisfies it.
+type RNG interface {
+	IntN(n int) int
+}
+
+// Roller rolls expressions with its RNG.
+type Roller struct {
+	rng RNG
+}
+
+// DieResult is a single die. Rolls holds every roll of an exploding die, Value their sum.
+type DieResult struct {
+	Value int   `json:"value"`
+	Rolls []int `json:"rolls,omitempty"`
+	Kept  bool  `json:"kept"`
+}
+
+// TermResult is the outcome of one term of an expression.
+type TermResult struct {
+	Term     string      `json:"term"`
+	Sign     int         `json:"sign"`
+	Dice     []DieResult `json:"dice,omitempty"`
+	Subtotal int         `json:"subtotal"`
+}
+
+// Result is the outcome of rolling an expression.
+type Result struct {
+	Notation string       `json:"notation"`
+	Total    int          `json:"total"`
+	Terms    []TermResult `json:"terms"`
+}
+
+// NewRoller creates a Roller drawing from rng.
+func NewRoller(rng RNG) *Roller {
+	return &Roller{rng: rng}
+}
+
+// NewSeededRoller creates a Roller whose rolls are fully determined by seed.
+func NewSeededRoller(seed uint64) *Roller {
+	return NewRoller(rand.New(rand.NewPCG(seed, seed)))
+}
+
+// Roll rolls every term of the expression.
+func (r *Roller) Roll(expr *Expression) *Result {
+	result := &Result{Notation: expr.Notation, Terms: make([]TermResult, 0, len(expr.Terms))}
+	for _, term := range expr.Terms {
+		termResult := r.rollTerm(term)
+		result.Total += termResult.Sign * termResult.Subtotal
+		result.Terms = append(result.Terms, termResult)
+	}
+	return result
+}
+
+func (r *Roller) rollTerm(term Term) TermResult {
+	result := TermResult{Term: term.String(), Sign: term.Sign}
+	if !term.IsDice() {
+		result.Subtotal = term.Constant
+		return result
+	}
+
+	result.Dice = make([]DieResult, term.Count)
+	for i := range result.Dice {
+		result.Dice[i] = r.rollDie(term)
+	}
+
+	kept := keptIndexes(result.Dice, term)
+	for _, i := range kept {
+		result.Dice[i].Kept = true
+		result.Subtotal += result.Dice[i].Value
+	}
+	return result
+}
+
+// rollDie rolls one die, rolling again and adding while an exploding die shows its highest face.
+func (r *Roller) rollDie(term Term) DieResult {
+	die := DieResult{}
+	for explosions := 0; ; explosions++ {
+		roll := r.rng.IntN(term.Sides) + 1
+		die.Rolls = append(die.Rolls, roll)
+		die.Value += roll
+		if !term.Explode || roll != term.Sides || explosions == MaxExplosions {
+			break
+		}
+	}
+	if len(die.Rolls) == 1 {
+		die.Rolls = nil
+	}
+	return die
+}
+
+// keptIndexes returns the indexes of the dice counted towards the term, preferring earlier dice on ties.
+func keptIndexes(dice []DieResult, term Term) []int {
+	indexes := make([]int, len(dice))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	if term.Keep == KeepAll {
+		return indexes
+	}
+
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		if term.Keep == KeepHighest {
+			return dice[b].Value - dice[a].Value
+		}
+		return dice[a].Value - dice[b].Value
+	})
+	return indexes[:term.KeepCount]
+}
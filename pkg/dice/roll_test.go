@@ -0,0 +1,127 @@
+package dice
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// sequence is an RNG returning fixed die faces, starting at 1, in order.
+type sequence struct {
+	faces []int
+}
+
+func (s *sequence) IntN(n int) int {
+	face := s.faces[0]
+	s.faces = s.faces[1:]
+	return face - 1
+}
+
+func TestSeededRollerIsDeterministic(t *testing.T) {
+	expr := MustParse("4d6kh3+1d20adv-1d8!+2")
+	for seed := range uint64(20) {
+		first := NewSeededRoller(seed).Roll(expr)
+		second := NewSeededRoller(seed).Roll(expr)
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("seed %d rolled %+v and then %+v", seed, first, second)
+		}
+	}
+}
+
+func TestRollStaysWithinBounds(t *testing.T) {
+	tests := []struct {
+		notation string
+		min, max int
+	}{
+		{"3d6+2", 5, 20},
+		{"4d6kh3", 3, 18},
+		{"1d20dis", 1, 20},
+		{"1d4-1d4", -3, 3},
+		{"1d6!", 1, 6 * (MaxExplosions + 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			expr := MustParse(tt.notation)
+			roller := NewSeededRoller(42)
+			for range 1000 {
+				result := roller.Roll(expr)
+				if result.Total < tt.min || result.Total > tt.max {
+					t.Fatalf("rolled %d, want between %d and %d", result.Total, tt.min, tt.max)
+				}
+
+				total := 0
+				for _, term := range result.Terms {
+					subtotal := 0
+					for _, die := range term.Dice {
+						if die.Kept {
+							subtotal += die.Value
+						}
+					}
+					if len(term.Dice) > 0 && subtotal != term.Subtotal {
+						t.Fatalf("term %s has subtotal %d, kept dice sum to %d", term.Term, term.Subtotal, subtotal)
+					}
+					total += term.Sign * term.Subtotal
+				}
+				if total != result.Total {
+					t.Fatalf("total %d, terms sum to %d", result.Total, total)
+				}
+			}
+		})
+	}
+}
+
+func TestRollKeepsHighestAndLowest(t *testing.T) {
+	tests := []struct {
+		notation string
+		faces    []int
+		kept     []bool
+		subtotal int
+	}{
+		{"4d6kh3", []int{2, 6, 1, 4}, []bool{true, true, false, true}, 12},
+		{"4d6kl1", []int{2, 6, 1, 4}, []bool{false, false, true, false}, 1},
+		{"1d20adv", []int{9, 9}, []bool{true, false}, 9},
+		{"1d20dis", []int{17, 3}, []bool{false, true}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			result := NewRoller(&sequence{faces: slices.Clone(tt.faces)}).Roll(MustParse(tt.notation))
+			term := result.Terms[0]
+			for i, die := range term.Dice {
+				if die.Kept != tt.kept[i] {
+					t.Errorf("die %d (%d) kept = %t, want %t", i, die.Value, die.Kept, tt.kept[i])
+				}
+			}
+			if term.Subtotal != tt.subtotal || result.Total != tt.subtotal {
+				t.Errorf("subtotal %d and total %d, want %d", term.Subtotal, result.Total, tt.subtotal)
+			}
+		})
+	}
+}
+
+func TestRollExplodes(t *testing.T) {
+	result := NewRoller(&sequence{faces: []int{6, 6, 3}}).Roll(MustParse("1d6!"))
+	die := result.Terms[0].Dice[0]
+	if die.Value != 15 || !slices.Equal(die.Rolls, []int{6, 6, 3}) {
+		t.Errorf("exploding die = %+v, want value 15 from rolls 6, 6 and 3", die)
+	}
+
+	sixes := make([]int, MaxExplosions+2)
+	for i := range sixes {
+		sixes[i] = 6
+	}
+	result = NewRoller(&sequence{faces: sixes}).Roll(MustParse("1d6!"))
+	die = result.Terms[0].Dice[0]
+	if len(die.Rolls) != MaxExplosions+1 || die.Value != 6*(MaxExplosions+1) {
+		t.Errorf("capped exploding die = %+v, want %d rolls of 6", die, MaxExplosions+1)
+	}
+}
+
+func TestRollSubtractsNegativeTerms(t *testing.T) {
+	result := NewRoller(&sequence{faces: []int{4, 1}}).Roll(MustParse("1d4-1d4+2"))
+	if result.Total != 5 {
+		t.Errorf("total = %d, want 4 - 1 + 2 = 5", result.Total)
+	}
+	if result.Terms[1].Sign != -1 || result.Terms[1].Subtotal != 1 {
+		t.Errorf("negative term = %+v, want sign -1 and subtotal 1", result.Terms[1])
+	}
+}
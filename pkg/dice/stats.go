@@ -0,0 +1,230 @@
+package dice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// maxStatsWork bounds the number of steps spent computing the exact distribution of a whole expression.
+const maxStatsWork = 50_000_000
+
+var ErrTooComplex = errors.New("dice expression too complex for exact statistics")
+
+// Outcome is the probability of rolling one total.
+type Outcome struct {
+	Total       int     `json:"total"`
+	Probability float64 `json:"probability"`
+}
+
+// Stats describes every total an expression can roll. Exploding dice are capped at MaxExplosions, exactly as
+// when rolling, so the distribution is exact.
+type Stats struct {
+	Notation          string    `json:"notation"`
+	Min               int       `json:"min"`
+	Max               int       `json:"max"`
+	Average           float64   `json:"average"`
+	StandardDeviation float64   `json:"standard_deviation"`
+	Distribution      []Outcome `json:"distribution"`
+}
+
+// distribution holds the probability of every total from offset to offset+len(probs)-1.
+type distribution struct {
+	offset int
+	probs  []float64
+}
+
+func constant(value int) distribution {
+	return distribution{offset: value, probs: []float64{1}}
+}
+
+func (d distribution) convolve(other distribution) distribution {
+	result := distribution{offset: d.offset + other.offset, probs: make([]float64, len(d.probs)+len(other.probs)-1)}
+	for i, p := range d.probs {
+		if p == 0 {
+			continue
+		}
+		for j, q := range other.probs {
+			result.probs[i+j] += p * q
+		}
+	}
+	return result
+}
+
+// statsBudget counts the steps spent on an expression, so that many terms that are each cheap enough cannot add
+// up to an unbounded amount of work.
+type statsBudget struct {
+	spent int
+}
+
+// spend records work steps for part of the expression and fails once the budget is exceeded.
+func (b *statsBudget) spend(work int, part string) error {
+	b.spent += work
+	if b.spent > maxStatsWork {
+		return fmt.Errorf("%w: %s", ErrTooComplex, part)
+	}
+	return nil
+}
+
+func (d distribution) negate() distribution {
+	result := distribution{offset: -(d.offset + len(d.probs) - 1), probs: make([]float64, len(d.probs))}
+	for i, p := range d.probs {
+		result.probs[len(d.probs)-1-i] = p
+	}
+	return result
+}
+
+// Analyze computes the exact distribution of the expression's totals.
+func Analyze(expr *Expression) (*Stats, error) {
+	budget := &statsBudget{}
+	total := constant(0)
+	for _, term := range expr.Terms {
+		dist, err := termDistribution(term, budget)
+		if err != nil {
+			return nil, err
+		}
+		if term.Sign < 0 {
+			dist = dist.negate()
+		}
+		if err := budget.spend(len(total.probs)*len(dist.probs), expr.Notation); err != nil {
+			return nil, err
+		}
+		total = total.convolve(dist)
+	}
+
+	stats := &Stats{Notation: expr.Notation, Distribution: []Outcome{}}
+	for i, p := range total.probs {
+		if p == 0 {
+			continue
+		}
+		value := total.offset + i
+		if len(stats.Distribution) == 0 {
+			stats.Min = value
+		}
+		stats.Max = value
+		stats.Average += float64(value) * p
+		stats.Distribution = append(stats.Distribution, Outcome{Total: value, Probability: p})
+	}
+
+	variance := 0.0
+	for _, outcome := range stats.Distribution {
+		delta := float64(outcome.Total) - stats.Average
+		variance += delta * delta * outcome.Probability
+	}
+	stats.StandardDeviation = math.Sqrt(variance)
+	return stats, nil
+}
+
+// dieDistribution is the distribution of a single die of the term, including explosions.
+func dieDistribution(term Term) distribution {
+	if !term.Explode {
+		die := distribution{offset: 1, probs: make([]float64, term.Sides)}
+		for i := range die.probs {
+			die.probs[i] = 1 / float64(term.Sides)
+		}
+		return die
+	}
+
+	// A die that explodes k times shows k*sides plus a final roll below sides, or any final roll once the cap is hit.
+	die := distribution{offset: 1, probs: make([]float64, (MaxExplosions+1)*term.Sides)}
+	chance := 1 / float64(term.Sides)
+	for k := 0; k <= MaxExplosions; k++ {
+		last := term.Sides - 1
+		if k == MaxExplosions {
+			last = term.Sides
+		}
+		for roll := 1; roll <= last; roll++ {
+			die.probs[k*term.Sides+roll-1] = chance
+		}
+		chance /= float64(term.Sides)
+	}
+	return die
+}
+
+// termDistribution is the distribution of a term's subtotal, before its sign is applied.
+func termDistribution(term Term, budget *statsBudget) (distribution, error) {
+	if !term.IsDice() {
+		return constant(term.Constant), nil
+	}
+
+	die := dieDistribution(term)
+	if term.Keep == KeepAll {
+		// The k-th convolution multiplies the k*(faces-1)+1 totals so far by the faces of one more die.
+		faces := len(die.probs)
+		if err := budget.spend(faces*(term.Count+(faces-1)*term.Count*(term.Count-1)/2), term.String()); err != nil {
+			return distribution{}, err
+		}
+		total := constant(0)
+		for range term.Count {
+			total = total.convolve(die)
+		}
+		return total, nil
+	}
+	return keptDistribution(term, die, budget)
+}
+
+// keptDistribution is the distribution of the sum of the KeepCount highest or lowest of Count dice. Faces are
+// visited from the first one kept to the last, deciding how many of the dice not assigned yet show each face;
+// the first KeepCount dice assigned are the ones kept.
+func keptDistribution(term Term, die distribution, budget *statsBudget) (distribution, error) {
+	faces := len(die.probs)
+	maxKept := term.KeepCount * (die.offset + faces - 1)
+	if err := budget.spend(faces*(term.Count+1)*(term.Count+1)*(maxKept+1), term.String()); err != nil {
+		return distribution{}, err
+	}
+
+	binomial := binomials(term.Count)
+
+	// states[n][s] is the probability that n dice are assigned so far with kept sum s.
+	states := make([][]float64, term.Count+1)
+	for n := range states {
+		states[n] = make([]float64, maxKept+1)
+	}
+	states[0][0] = 1
+
+	for step := range faces {
+		face := step
+		if term.Keep == KeepHighest {
+			face = faces - 1 - step
+		}
+		p := die.probs[face]
+		if p == 0 {
+			continue
+		}
+		value := die.offset + face
+
+		next := make([][]float64, term.Count+1)
+		for n := range next {
+			next[n] = make([]float64, maxKept+1)
+		}
+		for n := 0; n <= term.Count; n++ {
+			for sum, q := range states[n] {
+				if q == 0 {
+					continue
+				}
+				power := 1.0
+				for m := 0; n+m <= term.Count; m++ {
+					kept := max(0, min(m, term.KeepCount-n))
+					next[n+m][sum+kept*value] += q * binomial[term.Count-n][m] * power
+					power *= p
+				}
+			}
+		}
+		states = next
+	}
+
+	return distribution{offset: 0, probs: states[term.Count]}, nil
+}
+
+// binomials returns Pascal's triangle up to n.
+func binomials(n int) [][]float64 {
+	table := make([][]float64, n+1)
+	for i := range table {
+		table[i] = make([]float64, i+1)
+		table[i][0], table[i][i] = 1, 1
+		for j := 1; j < i; j++ {
+			table[i][j] = table[i-1][j-1] + table[i-1][j]
+		}
+	}
+	return table
+}
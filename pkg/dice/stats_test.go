@@ -0,0 +1,192 @@
+package dice
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+const tolerance = 1e-9
+
+// enumerate computes the distribution of an expression without exploding dice by rolling every combination of faces.
+func enumerate(expr *Expression) map[int]float64 {
+	totals := map[int]float64{0: 1}
+	for _, term := range expr.Terms {
+		subtotals := map[int]float64{term.Constant: 1}
+		if term.IsDice() {
+			subtotals = map[int]float64{}
+			faces := make([]int, term.Count)
+			for i := range faces {
+				faces[i] = 1
+			}
+			chance := math.Pow(1/float64(term.Sides), float64(term.Count))
+			for {
+				dice := make([]DieResult, len(faces))
+				for i, face := range faces {
+					dice[i] = DieResult{Value: face}
+				}
+				subtotal := 0
+				for _, i := range keptIndexes(dice, term) {
+					subtotal += dice[i].Value
+				}
+				subtotals[subtotal] += chance
+
+				i := 0
+				for i < len(faces) && faces[i] == term.Sides {
+					faces[i] = 1
+					i++
+				}
+				if i == len(faces) {
+					break
+				}
+				faces[i]++
+			}
+		}
+
+		next := map[int]float64{}
+		for total, p := range totals {
+			for subtotal, q := range subtotals {
+				next[total+term.Sign*subtotal] += p * q
+			}
+		}
+		totals = next
+	}
+	return totals
+}
+
+func TestAnalyzeMatchesEnumeration(t *testing.T) {
+	tests := []string{"2d6", "3d4+2", "1d20adv", "1d20dis", "4d6kh3", "5d4kl2", "1d6-1d4", "2d8dl1-3", "7"}
+	for _, notation := range tests {
+		t.Run(notation, func(t *testing.T) {
+			expr := MustParse(notation)
+			stats, err := Analyze(expr)
+			if err != nil {
+				t.Fatalf("Analyze returned error: %v", err)
+			}
+
+			want := enumerate(expr)
+			if len(stats.Distribution) != len(want) {
+				t.Fatalf("got %d totals, want %d", len(stats.Distribution), len(want))
+			}
+			sum := 0.0
+			for _, outcome := range stats.Distribution {
+				if math.Abs(outcome.Probability-want[outcome.Total]) > tolerance {
+					t.Errorf("P(%d) = %v, want %v", outcome.Total, outcome.Probability, want[outcome.Total])
+				}
+				sum += outcome.Probability
+			}
+			if math.Abs(sum-1) > tolerance {
+				t.Errorf("probabilities sum to %v", sum)
+			}
+		})
+	}
+}
+
+func TestAnalyzeSummary(t *testing.T) {
+	tests := []struct {
+		notation string
+		min, max int
+		average  float64
+		stddev   float64
+	}{
+		{"2d6", 2, 12, 7, math.Sqrt(35.0 / 6)},
+		{"1d20+5", 6, 25, 15.5, math.Sqrt(399.0 / 12)},
+		{"1d20adv", 1, 20, 13.825, math.Sqrt(213.325 - 13.825*13.825)},
+		{"1d4-1d4", -3, 3, 0, math.Sqrt(2 * 15.0 / 12)},
+		{"3", 3, 3, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.notation, func(t *testing.T) {
+			stats, err := Analyze(MustParse(tt.notation))
+			if err != nil {
+				t.Fatalf("Analyze returned error: %v", err)
+			}
+			if stats.Min != tt.min || stats.Max != tt.max {
+				t.Errorf("range = [%d, %d], want [%d, %d]", stats.Min, stats.Max, tt.min, tt.max)
+			}
+			if math.Abs(stats.Average-tt.average) > 1e-6 {
+				t.Errorf("average = %v, want %v", stats.Average, tt.average)
+			}
+			if math.Abs(stats.StandardDeviation-tt.stddev) > 1e-6 {
+				t.Errorf("standard deviation = %v, want %v", stats.StandardDeviation, tt.stddev)
+			}
+		})
+	}
+}
+
+func TestAnalyzeExplodingDie(t *testing.T) {
+	stats, err := Analyze(MustParse("1d6!"))
+	if err != nil {
+		t.Fatalf("Analyze returned error: %v", err)
+	}
+
+	// Each face below 6 ends the roll, and the last allowed explosion keeps any face.
+	want := 0.0
+	chance := 1.0 / 6
+	for k := 0; k <= MaxExplosions; k++ {
+		last := 5
+		if k == MaxExplosions {
+			last = 6
+		}
+		for face := 1; face <= last; face++ {
+			want += float64(6*k+face) * chance
+		}
+		chance /= 6
+	}
+	if math.Abs(stats.Average-want) > tolerance {
+		t.Errorf("average = %v, want %v", stats.Average, want)
+	}
+	if stats.Min != 1 || stats.Max != 6*(MaxExplosions+1) {
+		t.Errorf("range = [%d, %d], want [1, %d]", stats.Min, stats.Max, 6*(MaxExplosions+1))
+	}
+	for _, outcome := range stats.Distribution {
+		if outcome.Total%6 == 0 && outcome.Total != 6*(MaxExplosions+1) {
+			t.Errorf("total %d is impossible, a 6 always explodes", outcome.Total)
+		}
+	}
+}
+
+func TestAnalyzeAgreesWithSeededRolls(t *testing.T) {
+	for _, notation := range []string{"4d6kh3", "2d10!-1d4", "1d20dis+3"} {
+		t.Run(notation, func(t *testing.T) {
+			expr := MustParse(notation)
+			stats, err := Analyze(expr)
+			if err != nil {
+				t.Fatalf("Analyze returned error: %v", err)
+			}
+
+			const rolls = 20000
+			roller := NewSeededRoller(7)
+			sum := 0
+			for range rolls {
+				sum += roller.Roll(expr).Total
+			}
+			mean := float64(sum) / rolls
+			if limit := 5 * stats.StandardDeviation / math.Sqrt(rolls); math.Abs(mean-stats.Average) > limit {
+				t.Errorf("mean of %d seeded rolls = %v, exact average %v", rolls, mean, stats.Average)
+			}
+		})
+	}
+}
+
+func TestAnalyzeTooComplex(t *testing.T) {
+	tests := []string{
+		"100d1000",
+		"100d1000kh50",
+		strings.TrimSuffix(strings.Repeat("50d140+", MaxTerms), "+"),
+		strings.TrimSuffix(strings.Repeat("100d100+", MaxTerms), "+"),
+	}
+	for _, notation := range tests {
+		t.Run(notation[:min(len(notation), 20)], func(t *testing.T) {
+			start := time.Now()
+			if _, err := Analyze(MustParse(notation)); !errors.Is(err, ErrTooComplex) {
+				t.Fatalf("Analyze error = %v, want ErrTooComplex", err)
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("rejecting took %v", elapsed)
+			}
+		})
+	}
+}
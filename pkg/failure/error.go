@@ -9,12 +9,48 @@ var (
 	ErrorNotFound               = errors.New("not found")
 	ErrorMethodNotAllowed       = errors.New("method not allowed")
 	ErrorNotAcceptable          = errors.New("not acceptable")
+	ErrorUnsupportedMediaType   = errors.New("unsupported media type")
 	ErrorInternalServer         = errors.New("internal server error")
 	ErrorDeadlineExceeded       = errors.New("deadline exceeded")
 	ErrorEmailAlreadyRegistered = errors.New("email already registered")
+	ErrorConflict               = errors.New("conflict")
 	ErrorMigrate                = errors.New("migrate filed")
 )
 
+// sentinels lists the application errors recognised by Kind, most specific first.
+var sentinels = []error{
+	ErrorNotFound,
+	ErrorConflict,
+	ErrorBadRequest,
+	ErrorUnauthorized,
+	ErrorForbidden,
+	ErrorMethodNotAllowed,
+	ErrorNotAcceptable,
+	ErrorUnsupportedMediaType,
+	ErrorDeadlineExceeded,
+	ErrorEmailAlreadyRegistered,
+	ErrorInternalServer,
+}
+
+// Kind returns the application error wrapped by err, or fallback when err wraps none of them
+func Kind(err error, fallback error) error {
+	for _, sentinel := range sentinels {
+		if errors.Is(err, sentinel) {
+			return sentinel
+		}
+	}
+	return fallback
+}
+
+// NotFoundAsBadRequest reports err as a bad request when it wraps ErrorNotFound, for lookups of entries referenced by
+// a request rather than addressed by its URL, and returns any other error unchanged.
+func NotFoundAsBadRequest(err error) error {
+	if errors.Is(err, ErrorNotFound) {
+		return NewError(ErrorBadRequest, err)
+	}
+	return err
+}
+
 type Error struct {
 	appErr error
 	svcErr error
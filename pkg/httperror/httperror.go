@@ -22,27 +22,11 @@ func FormError(err error) APIError {
 	var apiError APIError
 	var svcError *failure.Error
 	if errors.As(err, &svcError) {
-		svcErr := svcError.SvcErr()
-		switch {
-		case errors.Is(svcErr, failure.ErrorInternalServer):
-			apiError.StatusCode = http.StatusInternalServerError
-		case errors.Is(svcErr, failure.ErrorBadRequest):
-			apiError.StatusCode = http.StatusBadRequest
-		case errors.Is(svcErr, failure.ErrorNotFound):
-			apiError.StatusCode = http.StatusNotFound
-		case errors.Is(svcErr, failure.ErrorUnauthorized):
-			apiError.StatusCode = http.StatusUnauthorized
-		case errors.Is(svcErr, failure.ErrorForbidden):
-			apiError.StatusCode = http.StatusForbidden
-		case errors.Is(svcErr, failure.ErrorDeadlineExceeded):
-			apiError.StatusCode = http.StatusRequestTimeout
-		case errors.Is(svcErr, failure.ErrorMethodNotAllowed):
-			apiError.StatusCode = http.StatusMethodNotAllowed
-		case errors.Is(svcErr, failure.ErrorNotAcceptable):
-			apiError.StatusCode = http.StatusNotAcceptable
-		case errors.Is(svcErr, failure.ErrorEmailAlreadyRegistered):
-			apiError.StatusCode = http.StatusConflict
-		default:
+		apiError.StatusCode = statusCode(svcError.AppErr())
+		if apiError.StatusCode == 0 {
+			apiError.StatusCode = statusCode(svcError.SvcErr())
+		}
+		if apiError.StatusCode == 0 {
 			apiError.StatusCode = http.StatusInternalServerError
 		}
 
@@ -51,3 +35,33 @@ func FormError(err error) APIError {
 	}
 	return apiError
 }
+
+// statusCode maps a failure sentinel to its HTTP status, returning 0 when err matches none of them.
+func statusCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, failure.ErrorInternalServer):
+		return http.StatusInternalServerError
+	case errors.Is(err, failure.ErrorBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, failure.ErrorNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, failure.ErrorUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, failure.ErrorForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, failure.ErrorDeadlineExceeded):
+		return http.StatusRequestTimeout
+	case errors.Is(err, failure.ErrorMethodNotAllowed):
+		return http.StatusMethodNotAllowed
+	case errors.Is(err, failure.ErrorNotAcceptable):
+		return http.StatusNotAcceptable
+	case errors.Is(err, failure.ErrorUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, failure.ErrorEmailAlreadyRegistered), errors.Is(err, failure.ErrorConflict):
+		return http.StatusConflict
+	default:
+		return 0
+	}
+}
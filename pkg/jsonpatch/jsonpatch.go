@@ -0,0 +1,364 @@
+// Package jsonpatch applies JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902) documents to JSON documents.
+package jsonpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Media types of the two patch formats.
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+var (
+	ErrInvalidPatch = errors.New("invalid patch")
+	ErrTestFailed   = errors.New("patch test failed")
+)
+
+// Operation is one operation of a JSON Patch. Value is only used by add, replace and test, and From by move and
+// copy.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// MergePatch applies an RFC 7396 merge patch to doc: members of patch objects replace those of doc recursively,
+// null members remove them and any other patch replaces doc entirely.
+func MergePatch(doc []byte, patch []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	changes, err := decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+	return json.Marshal(mergePatch(target, changes))
+}
+
+// Apply applies the operations of an RFC 6902 JSON Patch to doc in order. The patch is atomic: no document is
+// returned unless every operation succeeds, and a failing test operation returns ErrTestFailed.
+func Apply(doc []byte, patch []byte) ([]byte, error) {
+	root, err := decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+
+	var operations []Operation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, fmt.Errorf("%w: a JSON Patch is an array of operations: %w", ErrInvalidPatch, err)
+	}
+
+	for i, operation := range operations {
+		root, err = operation.apply(root)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+	return json.Marshal(root)
+}
+
+func (o *Operation) apply(root any) (any, error) {
+	path, err := parsePointer(o.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.Op {
+	case "add", "replace", "test":
+		if len(o.Value) == 0 {
+			return nil, fmt.Errorf("%w: %s needs a value", ErrInvalidPatch, o.Op)
+		}
+		value, err := decode(o.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+		}
+
+		switch o.Op {
+		case "add":
+			return add(root, path, value)
+		case "replace":
+			root, _, err := remove(root, path)
+			if err != nil {
+				return nil, err
+			}
+			return add(root, path, value)
+		default:
+			current, err := get(root, path)
+			if err != nil {
+				return nil, err
+			}
+			if !equal(current, value) {
+				return nil, fmt.Errorf("%w: the value at %s differs", ErrTestFailed, o.Path)
+			}
+			return root, nil
+		}
+	case "remove":
+		root, _, err := remove(root, path)
+		return root, err
+	case "move", "copy":
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return nil, err
+		}
+
+		if o.Op == "copy" {
+			value, err := get(root, from)
+			if err != nil {
+				return nil, err
+			}
+			return add(root, path, clone(value))
+		}
+
+		if len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+			return nil, fmt.Errorf("%w: cannot move %s into itself", ErrInvalidPatch, o.From)
+		}
+		root, value, err := remove(root, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(root, path, value)
+	default:
+		return nil, fmt.Errorf("%w: unknown operation '%s', allowed operations are add, remove, replace, move, copy and test", ErrInvalidPatch, o.Op)
+	}
+}
+
+func mergePatch(target any, patch any) any {
+	changes, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	object, ok := target.(map[string]any)
+	if !ok {
+		object = make(map[string]any)
+	}
+	for key, value := range changes {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+		object[key] = mergePatch(object[key], value)
+	}
+	return object
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped reference tokens. The empty pointer is the whole
+// document.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%w: path %s must be empty or start with /", ErrInvalidPatch, pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// index parses an array index token, which must be below size.
+func index(token string, size int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("%w: invalid array index '%s'", ErrInvalidPatch, token)
+	}
+	if i >= size {
+		return 0, fmt.Errorf("%w: array index %d is out of bounds", ErrInvalidPatch, i)
+	}
+	return i, nil
+}
+
+func get(node any, path []string) (any, error) {
+	for _, token := range path {
+		switch container := node.(type) {
+		case map[string]any:
+			value, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: member '%s' does not exist", ErrInvalidPatch, token)
+			}
+			node = value
+		case []any:
+			i, err := index(token, len(container))
+			if err != nil {
+				return nil, err
+			}
+			node = container[i]
+		default:
+			return nil, fmt.Errorf("%w: cannot reference '%s' inside a scalar", ErrInvalidPatch, token)
+		}
+	}
+	return node, nil
+}
+
+// add sets the member or inserts the array element at path and returns the updated node. Arrays grow by one,
+// and - appends to them.
+func add(node any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	token := path[0]
+	switch container := node.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			container[token] = value
+			return container, nil
+		}
+		child, ok := container[token]
+		if !ok {
+			return nil, fmt.Errorf("%w: member '%s' does not exist", ErrInvalidPatch, token)
+		}
+		child, err := add(child, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		container[token] = child
+		return container, nil
+	case []any:
+		if len(path) == 1 {
+			if token == "-" {
+				return append(container, value), nil
+			}
+			i, err := index(token, len(container)+1)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(container, i, value), nil
+		}
+		i, err := index(token, len(container))
+		if err != nil {
+			return nil, err
+		}
+		child, err := add(container[i], path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		container[i] = child
+		return container, nil
+	default:
+		return nil, fmt.Errorf("%w: cannot add '%s' inside a scalar", ErrInvalidPatch, token)
+	}
+}
+
+// remove deletes the member or array element at path, which must exist, and returns the updated node and the
+// removed value.
+func remove(node any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, node, nil
+	}
+
+	token := path[0]
+	switch container := node.(type) {
+	case map[string]any:
+		child, ok := container[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: member '%s' does not exist", ErrInvalidPatch, token)
+		}
+		if len(path) == 1 {
+			delete(container, token)
+			return container, child, nil
+		}
+		child, removed, err := remove(child, path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		container[token] = child
+		return container, removed, nil
+	case []any:
+		i, err := index(token, len(container))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(path) == 1 {
+			removed := container[i]
+			return slices.Delete(container, i, i+1), removed, nil
+		}
+		child, removed, err := remove(container[i], path[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		container[i] = child
+		return container, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: cannot remove '%s' inside a scalar", ErrInvalidPatch, token)
+	}
+}
+
+// decode parses a JSON value, keeping numbers as json.Number so large integers survive the round trip.
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return value, nil
+}
+
+// equal compares JSON values as RFC 6902 tests do: numbers by value and objects regardless of member order.
+func equal(a any, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		return ok && slices.EqualFunc(a, b, equal)
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		x, errA := a.Float64()
+		y, errB := b.Float64()
+		return errA == nil && errB == nil && x == y
+	default:
+		return a == b
+	}
+}
+
+// clone copies a decoded JSON value so the copy and the original can be changed independently.
+func clone(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, member := range value {
+			copied[key] = clone(member)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(value))
+		for i, element := range value {
+			copied[i] = clone(element)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,287 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// assertJSON fails unless got and want hold the same JSON value.
+func assertJSON(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("result is not JSON: %s", got)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("expected value is not JSON: %s", want)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string
+	}{
+		{
+			name:  "add member",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux"}]`,
+			want:  `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			name:  "add inserts into array",
+			doc:   `{"foo":["bar","baz"]}`,
+			patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			want:  `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:  "add at array length",
+			doc:   `{"foo":["bar"]}`,
+			patch: `[{"op":"add","path":"/foo/1","value":"baz"}]`,
+			want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:  "dash appends",
+			doc:   `{"foo":["bar"]}`,
+			patch: `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			want:  `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:  "add replaces the whole document",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"","value":[1,2]}]`,
+			want:  `[1,2]`,
+		},
+		{
+			name:  "remove member",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"remove","path":"/baz"}]`,
+			want:  `{"foo":"bar"}`,
+		},
+		{
+			name:  "remove array element",
+			doc:   `{"foo":["bar","qux","baz"]}`,
+			patch: `[{"op":"remove","path":"/foo/1"}]`,
+			want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:  "replace",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			want:  `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:  "replace with null",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/foo","value":null}]`,
+			want:  `{"foo":null}`,
+		},
+		{
+			name:  "move member",
+			doc:   `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:  "move array element",
+			doc:   `{"foo":["all","grass","cows","eat"]}`,
+			patch: `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			want:  `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:  "move onto itself",
+			doc:   `{"foo":{"bar":1}}`,
+			patch: `[{"op":"move","from":"/foo","path":"/foo"}]`,
+			want:  `{"foo":{"bar":1}}`,
+		},
+		{
+			name:  "move to a sibling sharing a prefix",
+			doc:   `{"a":1}`,
+			patch: `[{"op":"move","from":"/a","path":"/ab"}]`,
+			want:  `{"ab":1}`,
+		},
+		{
+			name:  "copy is independent of the original",
+			doc:   `{"foo":{"bar":1}}`,
+			patch: `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"replace","path":"/baz/bar","value":2}]`,
+			want:  `{"foo":{"bar":1},"baz":{"bar":2}}`,
+		},
+		{
+			name:  "escaped slash",
+			doc:   `{"a/b":1}`,
+			patch: `[{"op":"replace","path":"/a~1b","value":2}]`,
+			want:  `{"a/b":2}`,
+		},
+		{
+			name:  "escaped tilde",
+			doc:   `{"m~n":1}`,
+			patch: `[{"op":"replace","path":"/m~0n","value":2}]`,
+			want:  `{"m~n":2}`,
+		},
+		{
+			name:  "escapes are decoded in order",
+			doc:   `{"~1":1,"/":2}`,
+			patch: `[{"op":"remove","path":"/~01"}]`,
+			want:  `{"/":2}`,
+		},
+		{
+			name:  "empty member name",
+			doc:   `{"":1}`,
+			patch: `[{"op":"replace","path":"/","value":2}]`,
+			want:  `{"":2}`,
+		},
+		{
+			name:  "test passes",
+			doc:   `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch: `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			want:  `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:  "test compares numbers by value",
+			doc:   `{"n":1,"f":2.50}`,
+			patch: `[{"op":"test","path":"/n","value":1.0},{"op":"test","path":"/n","value":1e0},{"op":"test","path":"/f","value":2.5}]`,
+			want:  `{"n":1,"f":2.5}`,
+		},
+		{
+			name:  "test ignores member order",
+			doc:   `{"o":{"a":1,"b":[1,{"c":null}]}}`,
+			patch: `[{"op":"test","path":"/o","value":{"b":[1,{"c":null}],"a":1}}]`,
+			want:  `{"o":{"a":1,"b":[1,{"c":null}]}}`,
+		},
+		{
+			name:  "no operations",
+			doc:   `{"foo":"bar"}`,
+			patch: `[]`,
+			want:  `{"foo":"bar"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply([]byte(tt.doc), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Apply returned error: %v", err)
+			}
+			assertJSON(t, got, tt.want)
+		})
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  error
+	}{
+		{"not an array", `{}`, `{"op":"add","path":"/a","value":1}`, ErrInvalidPatch},
+		{"unknown operation", `{}`, `[{"op":"merge","path":"/a","value":1}]`, ErrInvalidPatch},
+		{"missing value", `{}`, `[{"op":"add","path":"/a"}]`, ErrInvalidPatch},
+		{"path without slash", `{"a":1}`, `[{"op":"remove","path":"a"}]`, ErrInvalidPatch},
+		{"add to missing parent", `{}`, `[{"op":"add","path":"/a/b","value":1}]`, ErrInvalidPatch},
+		{"remove missing member", `{"a":1}`, `[{"op":"remove","path":"/b"}]`, ErrInvalidPatch},
+		{"replace missing member", `{"a":1}`, `[{"op":"replace","path":"/b","value":1}]`, ErrInvalidPatch},
+		{"index out of bounds", `{"a":[1]}`, `[{"op":"add","path":"/a/2","value":1}]`, ErrInvalidPatch},
+		{"leading zero index", `{"a":[1,2]}`, `[{"op":"replace","path":"/a/01","value":3}]`, ErrInvalidPatch},
+		{"negative index", `{"a":[1,2]}`, `[{"op":"remove","path":"/a/-1"}]`, ErrInvalidPatch},
+		{"dash outside add", `{"a":[1,2]}`, `[{"op":"remove","path":"/a/-"}]`, ErrInvalidPatch},
+		{"dash in the middle of a path", `{"a":[{"b":1}]}`, `[{"op":"add","path":"/a/-/b","value":2}]`, ErrInvalidPatch},
+		{"member of a scalar", `{"a":1}`, `[{"op":"add","path":"/a/b","value":1}]`, ErrInvalidPatch},
+		{"move into itself", `{"a":{"b":{}}}`, `[{"op":"move","from":"/a","path":"/a/b/c"}]`, ErrInvalidPatch},
+		{"move from missing member", `{"a":1}`, `[{"op":"move","from":"/b","path":"/c"}]`, ErrInvalidPatch},
+		{"test fails", `{"a":"b"}`, `[{"op":"test","path":"/a","value":"c"}]`, ErrTestFailed},
+		{"test compares types", `{"a":1}`, `[{"op":"test","path":"/a","value":"1"}]`, ErrTestFailed},
+		{"test compares array length", `{"a":[1,2]}`, `[{"op":"test","path":"/a","value":[1]}]`, ErrTestFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply([]byte(tt.doc), []byte(tt.patch))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Apply error = %v, want %v", err, tt.want)
+			}
+			if got != nil {
+				t.Errorf("Apply returned %s along with an error", got)
+			}
+		})
+	}
+}
+
+func TestApplyIsAtomic(t *testing.T) {
+	doc := []byte(`{"a":[1,2],"b":{"c":"d"}}`)
+	original := string(doc)
+	patch := []byte(`[
+		{"op":"remove","path":"/a/0"},
+		{"op":"add","path":"/b/e","value":"f"},
+		{"op":"test","path":"/b/c","value":"x"}
+	]`)
+
+	got, err := Apply(doc, patch)
+	if !errors.Is(err, ErrTestFailed) {
+		t.Fatalf("Apply error = %v, want ErrTestFailed", err)
+	}
+	if got != nil {
+		t.Errorf("a failed patch returned %s", got)
+	}
+	if string(doc) != original {
+		t.Errorf("a failed patch changed the document to %s", doc)
+	}
+}
+
+func TestApplyKeepsLargeNumbers(t *testing.T) {
+	got, err := Apply([]byte(`{"id":12345678901234567890}`), []byte(`[{"op":"add","path":"/n","value":98765432109876543210}]`))
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if want := `{"id":12345678901234567890,"n":98765432109876543210}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		doc   string
+		patch string
+		want  string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.patch, func(t *testing.T) {
+			got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("MergePatch returned error: %v", err)
+			}
+			assertJSON(t, got, tt.want)
+		})
+	}
+}
+
+func TestMergePatchInvalid(t *testing.T) {
+	for _, patch := range []string{``, `{"a":`, `{"a":1} {"b":2}`} {
+		t.Run(patch, func(t *testing.T) {
+			if _, err := MergePatch([]byte(`{}`), []byte(patch)); !errors.Is(err, ErrInvalidPatch) {
+				t.Errorf("MergePatch error = %v, want ErrInvalidPatch", err)
+			}
+		})
+	}
+}